@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"filippo.io/age"
+	"filippo.io/age/plugin"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmos "github.com/cometbft/cometbft/libs/os"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -46,20 +47,25 @@ import (
 	"github.com/crypto-org-chain/cronos/client/docs"
 	cmdcfg "github.com/crypto-org-chain/cronos/cmd/cronosd/config"
 	"github.com/crypto-org-chain/cronos/x/cronos"
+	"github.com/crypto-org-chain/cronos/x/cronos/blockquery"
 	cronosclient "github.com/crypto-org-chain/cronos/x/cronos/client"
+	"github.com/crypto-org-chain/cronos/x/cronos/ibctracker"
 	cronoskeeper "github.com/crypto-org-chain/cronos/x/cronos/keeper"
 	evmhandlers "github.com/crypto-org-chain/cronos/x/cronos/keeper/evmhandlers"
+	cronosprecompiles "github.com/crypto-org-chain/cronos/x/cronos/keeper/precompiles"
 	"github.com/crypto-org-chain/cronos/x/cronos/middleware"
-	// force register the extension json-rpc.
-	_ "github.com/crypto-org-chain/cronos/x/cronos/rpc"
+	// also registers the extension json-rpc.
+	cronosrpc "github.com/crypto-org-chain/cronos/x/cronos/rpc"
 	cronostypes "github.com/crypto-org-chain/cronos/x/cronos/types"
 	e2ee "github.com/crypto-org-chain/cronos/x/e2ee"
 	e2eekeeper "github.com/crypto-org-chain/cronos/x/e2ee/keeper"
 	e2eekeyring "github.com/crypto-org-chain/cronos/x/e2ee/keyring"
 	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/ante/cache"
 	"github.com/evmos/ethermint/appmempool"
 	evmenc "github.com/evmos/ethermint/encoding"
@@ -77,6 +83,7 @@ import (
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cast"
+	"google.golang.org/grpc"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -188,6 +195,12 @@ const (
 	FlagMaxTxPerBlock                = "cronos.mempool-txs-per-block"
 	FlagMempoolTxTTLEnabled          = "cronos.mempool-tx-ttl-enabled"
 	FlagMempoolPendingTxCacheEnabled = "cronos.mempool-pending-tx-cache-enabled"
+	FlagE2EEIdentityReloadInterval   = "cronos.e2ee-identity-reload-interval"
+	FlagE2EEIdentityGracePeriod      = "cronos.e2ee-identity-grace-period"
+	FlagE2EERemoteKeyring            = "cronos.e2ee-remote-keyring"
+	FlagE2EERemoteKeyringTLSCert     = "cronos.e2ee-remote-keyring-tls-cert"
+	FlagE2EERemoteKeyringTLSKey      = "cronos.e2ee-remote-keyring-tls-key"
+	FlagE2EERemoteKeyringTLSCA       = "cronos.e2ee-remote-keyring-tls-ca"
 )
 
 // recheckWaitTimeout bounds how long PrepareProposal waits for an in-flight async
@@ -267,7 +280,7 @@ func StoreKeys() (
 		cronostypes.StoreKey,
 	}
 	keys := storetypes.NewKVStoreKeys(storeKeys...)
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, cronostypes.TStoreKey)
 	okeys := storetypes.NewObjectStoreKeys(banktypes.ObjectStoreKey, evmtypes.ObjectStoreKey)
 
 	return keys, tkeys, okeys
@@ -346,6 +359,11 @@ type App struct {
 
 	blockProposalHandler *ProposalHandler
 
+	// identityReloader is nil if it's not a validator node
+	identityReloader *identityReloader
+	// changeSetIndex is nil unless versiondb.changeset-index is enabled
+	changeSetIndex *cronosrpc.ChangeSetIndex
+
 	mempoolManager *cronosmempool.Manager
 
 	senderCache *cache.SenderCache
@@ -424,24 +442,62 @@ func New(
 
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 	var identity age.Identity
+	var identityReloader *identityReloader
+	var pluginUI *plugin.ClientUI
 	{
 		if cast.ToString(appOpts.Get("mode")) == "validator" {
-			krBackend := cast.ToString(appOpts.Get(flags.FlagKeyringBackend))
-			kr, err := e2eekeyring.New("cronosd", krBackend, homePath, os.Stdin)
+			var kr e2eekeyring.Keyring
+			var err error
+			if remote := cast.ToString(appOpts.Get(FlagE2EERemoteKeyring)); remote != "" {
+				// the unwrapped file keys cross the connection, only a unix socket goes without tls.
+				var creds grpc.DialOption
+				creds, err = e2eekeyring.ClientCredentials(remote, e2eekeyring.TLSConfig{
+					CertFile: cast.ToString(appOpts.Get(FlagE2EERemoteKeyringTLSCert)),
+					KeyFile:  cast.ToString(appOpts.Get(FlagE2EERemoteKeyringTLSKey)),
+					CAFile:   cast.ToString(appOpts.Get(FlagE2EERemoteKeyringTLSCA)),
+				})
+				if err == nil {
+					kr, err = e2eekeyring.NewRemote(remote, creds)
+				}
+			} else {
+				krBackend := cast.ToString(appOpts.Get(flags.FlagKeyringBackend))
+				kr, err = e2eekeyring.New("cronosd", krBackend, homePath, os.Stdin)
+			}
 			if err != nil {
 				panic(err)
 			}
-			bz, err := kr.Get(e2eetypes.DefaultKeyringName)
-			if err != nil {
-				logger.Error("e2ee identity for validator not found", "error", err)
-				identity = noneIdentity{}
-			} else {
-				identity, err = age.ParseX25519Identity(string(bz))
+			reloadInterval := cmdcfg.DefaultE2EEIdentityReloadInterval
+			if v := appOpts.Get(FlagE2EEIdentityReloadInterval); v != nil {
+				reloadInterval, err = cast.ToDurationE(v)
+				if err != nil {
+					panic(fmt.Errorf("invalid %s %q: %w", FlagE2EEIdentityReloadInterval, v, err))
+				}
+			}
+			gracePeriod := cmdcfg.DefaultE2EEIdentityGracePeriod
+			if v := appOpts.Get(FlagE2EEIdentityGracePeriod); v != nil {
+				gracePeriod, err = cast.ToDurationE(v)
 				if err != nil {
-					logger.Error("e2ee identity for validator is invalid", "error", err)
-					identity = noneIdentity{}
+					panic(fmt.Errorf("invalid %s %q: %w", FlagE2EEIdentityGracePeriod, v, err))
 				}
 			}
+			// the node can't prompt, the plugins needing user input fail to unwrap.
+			pluginUI = &plugin.ClientUI{
+				DisplayMessage: func(name, message string) error {
+					logger.Info("e2ee identity plugin", "plugin", name, "message", message)
+					return nil
+				},
+			}
+			identityReloader = newIdentityReloader(kr, pluginUI, gracePeriod, logger)
+			if _, err := identityReloader.Reload(); err != nil {
+				// the decryption fails until the identity is loaded by a reload.
+				logger.Error("e2ee identity for validator is not loaded", "error", err)
+			}
+			identityReloader.Start(reloadInterval)
+			if err := identityReloader.Serve(e2eetypes.NodeSocketPath(homePath)); err != nil {
+				// the identity is still reloaded on SIGHUP and by the polling.
+				logger.Error("failed to serve e2ee node service", "error", err)
+			}
+			identity = identityReloader.identity
 		}
 	}
 
@@ -559,7 +615,7 @@ func New(
 			// blocklist + baseFee gate.
 			h := baseapp.NewDefaultProposalHandler(mpool, NewCacheProposalTxVerifier(app, encCache))
 			ppHandler = NewMempoolProposalHandler(h, blockProposalHandler.ValidateTransaction, feeGate, signerExtractor)
-			app.SetPrepareProposal(ppHandler.PrepareProposalHandler())
+			app.SetPrepareProposal(blockProposalHandler.ProposeThresholdList(ppHandler.PrepareProposalHandler()))
 		} else {
 			// flood mempool, or mempool.type=app with cache disabled: full-ante
 			// default handler. ExtTxSelector still applies the blocklist + gas/byte
@@ -572,13 +628,17 @@ func New(
 			if signerExtractor != nil {
 				defaultProposalHandler.SetSignerExtractionAdapter(signerExtractor)
 			}
-			app.SetPrepareProposal(defaultProposalHandler.PrepareProposalHandler())
+			app.SetPrepareProposal(blockProposalHandler.ProposeThresholdList(defaultProposalHandler.PrepareProposalHandler()))
 		}
 
 		// The default process proposal handler do nothing when the mempool is noop,
 		// so we just implement a new one.
 		app.SetProcessProposal(blockProposalHandler.ProcessProposalHandler())
 
+		// the validators exchange their sealed partial decryptions of the threshold block list in the vote extensions.
+		app.SetExtendVoteHandler(blockProposalHandler.ExtendVoteHandler())
+		app.SetVerifyVoteExtensionHandler(blockProposalHandler.VerifyVoteExtensionHandler())
+
 		// Wire app-side mempool ABCI hooks for mempool.type=app.
 		// InsertTxHandler runs ante via RunTx(execModeCheck) at admission;
 		// ReapTxsHandler honors MaxBytes/MaxGas hints from the CometBFT AppReactor.
@@ -591,6 +651,8 @@ func New(
 			app.SetReapTxsHandler(cronosmempool.NewReapTxsHandler(mpool, txConfig.TxEncoder(), encCache, gossipTTL, txsPerBlock, logger.With("module", "app-mempool")))
 			manager := cronosmempool.NewManager(app, encCache, txConfig.TxEncoder(), mpool, signerExtractor, activeDecoder, txsPerBlock, ttlNumBlocks, !recheckEnabled, pendingCacheEnabled)
 			manager.SetAnteCache(anteCache)
+			// feeds the cronos_subscribe mempoolEvictions json-rpc subscriptions.
+			manager.SetEvictionListener(cronosrpc.NotifyMempoolEviction)
 			var preVerifiers cronosmempool.PreVerifierRegistry
 			preVerifiers.Register(appmempool.NewEVMSigPreVerifier(app.ChainID(), activeDecoder, senderCache))
 			manager.SetPreVerify(preVerifiers.Verify)
@@ -600,6 +662,13 @@ func New(
 			if ppHandler != nil {
 				ppHandler.SetMempoolManager(manager)
 			}
+			cronosrpc.SetAppMempool(rpcMempool{
+				manager:     manager,
+				proposal:    ppHandler,
+				txsPerBlock: txsPerBlock,
+				anteCache:   anteCache,
+				blocklist:   blockProposalHandler,
+			})
 		}
 	})
 
@@ -643,6 +712,7 @@ func New(
 		tkeys:                tkeys,
 		okeys:                okeys,
 		blockProposalHandler: blockProposalHandler,
+		identityReloader:     identityReloader,
 		mempoolManager:       mempoolManager,
 		senderCache:          senderCache,
 		anteCache:            anteCache,
@@ -829,7 +899,11 @@ func New(
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
 		tracer,
 		evmS,
-		[]evmkeeper.CustomContractFn{},
+		[]evmkeeper.CustomContractFn{
+			func(ctx sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewE2EEContract(app.E2EEKeeper, storetypes.KVGasConfig(), app.e2eePrecompileEnabled(ctx))
+			},
+		},
 		cast.ToUint64(appOpts.Get(server.FlagQueryGasLimit)),
 	)
 
@@ -840,14 +914,24 @@ func New(
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
+	app.E2EEKeeper = e2eekeeper.NewKeeper(
+		appCodec,
+		keys[e2eetypes.StoreKey],
+		app.AccountKeeper.AddressCodec(),
+		app.StakingKeeper,
+		app.BankKeeper,
+		authAddr,
+	)
+
 	app.CronosKeeper = *cronoskeeper.NewKeeper(
 		appCodec,
 		keys[cronostypes.StoreKey],
-		keys[cronostypes.MemStoreKey],
+		tkeys[cronostypes.TStoreKey],
 		app.BankKeeper,
 		app.TransferKeeper,
 		app.EvmKeeper,
 		app.AccountKeeper,
+		app.E2EEKeeper,
 		authAddr,
 	)
 	cronosModule := cronos.NewAppModule(app.CronosKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(cronostypes.ModuleName))
@@ -893,6 +977,8 @@ func New(
 		evmhandlers.NewSendToIbcHandler(app.BankKeeper, app.CronosKeeper),
 		evmhandlers.NewSendCroToIbcHandler(app.BankKeeper, app.CronosKeeper),
 		evmhandlers.NewSendToIbcV2Handler(app.BankKeeper, app.CronosKeeper),
+	).SetLogDispatcher(
+		evmhandlers.NewLogActionDispatcher(appCodec, app.MsgServiceRouter(), app.CronosKeeper),
 	))
 
 	// Hoist EVM signature verification (ecrecover) out of the app-mempool
@@ -934,8 +1020,6 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.E2EEKeeper = e2eekeeper.NewKeeper(keys[e2eetypes.StoreKey], app.AccountKeeper.AddressCodec())
-
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -1052,6 +1136,7 @@ func New(
 		vestingtypes.ModuleName,
 		cronostypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		e2eetypes.ModuleName,
 	}
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -1107,7 +1192,7 @@ func New(
 	// wire up the versiondb's `StreamingService` and `MultiStore`.
 	if cast.ToBool(appOpts.Get("versiondb.enable")) {
 		var err error
-		app.qms, err = app.setupVersionDB(homePath, keys, tkeys, okeys)
+		app.qms, err = app.setupVersionDB(homePath, keys, tkeys, okeys, cast.ToBool(appOpts.Get("versiondb.changeset-index")))
 		if err != nil {
 			panic(err)
 		}
@@ -1348,7 +1433,11 @@ func (app *App) MempoolClient() appmempool.MempoolClient {
 func (app *App) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker updates every pre begin block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// the validators switch to the threshold block list at the same height.
+	if err := app.blockProposalHandler.ApplyThresholdList(req.Txs); err != nil {
+		app.Logger().Error("failed to decrypt the threshold block list", "error", err)
+	}
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -1497,6 +1586,8 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the ibc transfer tracking routes backed by the tx indexer.
+	ibctracker.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
@@ -1507,6 +1598,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
+	ibctracker.RegisterIbcTransferService(app.GRPCQueryRouter(), clientCtx)
+	// serve the cronos block queries with the blocks and block results of the node.
+	app.CronosKeeper.SetBlockQuerier(blockquery.NewServer(clientCtx, app.CreateQueryContext, app.CronosKeeper, app.EvmKeeper))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -1629,6 +1723,9 @@ func (app *App) Close() error {
 	if app.mempoolManager != nil {
 		app.mempoolManager.Close()
 	}
+	if app.identityReloader != nil {
+		app.identityReloader.Close()
+	}
 
 	errs := []error{app.BaseApp.Close()}
 
@@ -1636,6 +1733,10 @@ func (app *App) Close() error {
 	if closer, ok := app.qms.(io.Closer); ok {
 		errs = append(errs, closer.Close())
 	}
+	// after versiondb, no more change set is streamed
+	if app.changeSetIndex != nil {
+		errs = append(errs, app.changeSetIndex.Close())
+	}
 
 	// mainly to flush memiavl
 	if closer, ok := app.CommitMultiStore().(io.Closer); ok {
@@ -0,0 +1,197 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/plugin"
+	e2eekeyring "github.com/crypto-org-chain/cronos/x/e2ee/keyring"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log/v2"
+)
+
+var _ e2eetypes.NodeServer = (*identityReloader)(nil)
+
+// identityReloader reloads the e2ee identity of the validator from the keyring without restarting the node, it's
+// triggered by polling the keyring, SIGHUP or the ReloadIdentity grpc call on the node-local socket. The replaced
+// identity keeps decrypting the block list during the grace period of the rotating identity.
+type identityReloader struct {
+	// mu serializes the reloads from the different triggers.
+	mu       sync.Mutex
+	kr       e2eekeyring.Keyring
+	ui       *plugin.ClientUI
+	identity *e2eekeyring.RotatingIdentity
+	// secret is the identity loaded last, or its recipient for the remote keyring, the reload is skipped if the
+	// keyring is unchanged.
+	secret []byte
+	logger log.Logger
+	// srv serves the Node service on the node-local socket, nil until Serve.
+	srv *grpc.Server
+
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+func newIdentityReloader(
+	kr e2eekeyring.Keyring,
+	ui *plugin.ClientUI,
+	gracePeriod time.Duration,
+	logger log.Logger,
+) *identityReloader {
+	return &identityReloader{
+		kr:       kr,
+		ui:       ui,
+		identity: e2eekeyring.NewRotatingIdentity(nil, gracePeriod),
+		logger:   logger,
+		quit:     make(chan struct{}),
+	}
+}
+
+// Reload loads the identity from the keyring, the current identity is rotated if it's changed.
+func (r *identityReloader) Reload() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		identity age.Identity
+		secret   []byte
+		err      error
+	)
+	if provider, ok := r.kr.(e2eekeyring.IdentityProvider); ok {
+		// the remote keyring doesn't export the secret, the recipient tells if the key is rotated.
+		var recipient string
+		identity, recipient, err = provider.Identity(e2eetypes.DefaultKeyringName)
+		if err != nil {
+			return false, err
+		}
+		secret = []byte(recipient)
+		if bytes.Equal(secret, r.secret) {
+			return false, nil
+		}
+	} else {
+		secret, err = r.kr.Get(e2eetypes.DefaultKeyringName)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(secret, r.secret) {
+			return false, nil
+		}
+		identity, err = e2eekeyring.ParseIdentity(secret, r.ui)
+		if err != nil {
+			return false, err
+		}
+	}
+	r.identity.Rotate(identity)
+	r.secret = secret
+	return true, nil
+}
+
+// reload reloads the identity and logs the result, for the triggers without a caller to report to.
+func (r *identityReloader) reload(trigger string) {
+	rotated, err := r.Reload()
+	switch {
+	case err != nil:
+		r.logger.Error("failed to reload e2ee identity", "trigger", trigger, "error", err)
+	case rotated:
+		r.logger.Info("e2ee identity reloaded", "trigger", trigger)
+	}
+}
+
+// Start polls the keyring at the interval, zero disables the polling, and reloads on SIGHUP until closed.
+func (r *identityReloader) Start(interval time.Duration) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+
+	var tick <-chan time.Time
+	var ticker *time.Ticker
+	if interval > 0 {
+		ticker = time.NewTicker(interval)
+		tick = ticker.C
+	}
+
+	go func() {
+		defer signal.Stop(sigs)
+		if ticker != nil {
+			defer ticker.Stop()
+		}
+		for {
+			select {
+			case <-r.quit:
+				return
+			case <-sigs:
+				r.reload("signal")
+			case <-tick:
+				r.reload("watch")
+			}
+		}
+	}()
+}
+
+// Serve serves the Node service on the unix socket at path until closed, it's not registered on the public grpc
+// server, only the operator with access to the node home can trigger the reload.
+func (r *identityReloader) Serve(path string) error {
+	// a stale socket is left if the node was killed.
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		lis.Close()
+		return err
+	}
+	r.srv = grpc.NewServer()
+	e2eetypes.RegisterNodeServer(r.srv, r)
+	go func() {
+		if err := r.srv.Serve(lis); err != nil {
+			r.logger.Error("e2ee node service stopped", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Close stops the triggers started by Start and Serve, and closes the connection of the remote keyring.
+func (r *identityReloader) Close() {
+	r.closeOnce.Do(func() {
+		close(r.quit)
+		if r.srv != nil {
+			// the socket file is removed by the listener.
+			r.srv.Stop()
+		}
+		if closer, ok := r.kr.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				r.logger.Error("failed to close e2ee keyring", "error", err)
+			}
+		}
+	})
+}
+
+// ReloadIdentity implements the e2ee Node service, it only reloads the local keyring, the identity is never
+// taken from the request.
+func (r *identityReloader) ReloadIdentity(
+	_ context.Context,
+	_ *e2eetypes.ReloadIdentityRequest,
+) (*e2eetypes.ReloadIdentityResponse, error) {
+	rotated, err := r.Reload()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if rotated {
+		r.logger.Info("e2ee identity reloaded", "trigger", "grpc")
+	}
+	return &e2eetypes.ReloadIdentityResponse{Rotated: rotated}, nil
+}
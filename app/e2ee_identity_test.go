@@ -0,0 +1,133 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	e2eekeyring "github.com/crypto-org-chain/cronos/x/e2ee/keyring"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/log/v2"
+
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestIdentityReloaderRotatesBlockListIdentity(t *testing.T) {
+	kr, err := e2eekeyring.New("cronosd", sdkkeyring.BackendMemory, "", nil)
+	require.NoError(t, err)
+	reloader := newIdentityReloader(kr, nil, time.Hour, log.NewNopLogger())
+
+	// the identity is not in the keyring yet
+	_, err = reloader.Reload()
+	require.Error(t, err)
+
+	addressCodec := authcodec.NewBech32Codec("cosmos")
+	h := NewProposalHandler(nil, reloader.identity, addressCodec)
+	blocked, err := addressCodec.BytesToString(bytes.Repeat([]byte{0x1}, 20))
+	require.NoError(t, err)
+
+	oldKey, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	require.NoError(t, kr.Set(e2eetypes.DefaultKeyringName, []byte(oldKey.String())))
+	rotated, err := reloader.Reload()
+	require.NoError(t, err)
+	require.True(t, rotated)
+	toOld := encryptBlockList(t, oldKey.Recipient(), blocked)
+	require.NoError(t, h.SetBlockList(toOld))
+
+	newKey, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	require.NoError(t, kr.Set(e2eetypes.DefaultKeyringName, []byte(newKey.String())))
+	rsp, err := reloader.ReloadIdentity(context.Background(), &e2eetypes.ReloadIdentityRequest{})
+	require.NoError(t, err)
+	require.True(t, rsp.Rotated)
+	rotated, err = reloader.Reload()
+	require.NoError(t, err)
+	require.False(t, rotated)
+
+	// both the blobs encrypted to the old and the new keys are decrypted in the grace period
+	require.NoError(t, h.SetBlockList(encryptBlockList(t, newKey.Recipient())))
+	require.Empty(t, h.blocklist)
+	require.NoError(t, h.SetBlockList(toOld))
+	_, ok := h.blocklist[blocked]
+	require.True(t, ok)
+}
+
+func TestReloadIdentitySocket(t *testing.T) {
+	kr, err := e2eekeyring.New("cronosd", sdkkeyring.BackendMemory, "", nil)
+	require.NoError(t, err)
+	reloader := newIdentityReloader(kr, nil, time.Hour, log.NewNopLogger())
+	path := filepath.Join(t.TempDir(), e2eetypes.NodeSocketName)
+	require.NoError(t, reloader.Serve(path))
+	defer reloader.Close()
+
+	// only the owner of the node home can connect
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	conn, err := grpc.NewClient(
+		e2eekeyring.UnixSocketScheme+path,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := e2eetypes.NewNodeClient(conn)
+
+	// the identity is not in the keyring yet
+	_, err = client.ReloadIdentity(context.Background(), &e2eetypes.ReloadIdentityRequest{})
+	require.Error(t, err)
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	require.NoError(t, kr.Set(e2eetypes.DefaultKeyringName, []byte(identity.String())))
+	rsp, err := client.ReloadIdentity(context.Background(), &e2eetypes.ReloadIdentityRequest{})
+	require.NoError(t, err)
+	require.True(t, rsp.Rotated)
+
+	reloader.Close()
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+}
+
+// remoteKeyring is an identity provider keeping the secret to itself, like the remote keyring.
+type remoteKeyring struct {
+	identity *age.X25519Identity
+}
+
+func (remoteKeyring) Get(string) ([]byte, error) { return nil, e2eekeyring.ErrSecretNotExportable }
+func (remoteKeyring) Set(string, []byte) error   { return e2eekeyring.ErrSecretNotExportable }
+
+func (kr *remoteKeyring) Identity(string) (age.Identity, string, error) {
+	return kr.identity, kr.identity.Recipient().String(), nil
+}
+
+func TestIdentityReloaderRemoteKeyring(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	kr := &remoteKeyring{identity: identity}
+	reloader := newIdentityReloader(kr, nil, time.Hour, log.NewNopLogger())
+
+	rotated, err := reloader.Reload()
+	require.NoError(t, err)
+	require.True(t, rotated)
+	rotated, err = reloader.Reload()
+	require.NoError(t, err)
+	require.False(t, rotated)
+
+	kr.identity, err = age.GenerateX25519Identity()
+	require.NoError(t, err)
+	rotated, err = reloader.Reload()
+	require.NoError(t, err)
+	require.True(t, rotated)
+	require.Len(t, reloader.identity.Identities(), 2)
+}
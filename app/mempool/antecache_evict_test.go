@@ -82,7 +82,7 @@ func evictClearsAnteCacheEntry(t *testing.T) {
 	a.mpool = &fakePool{txs: []sdk.Tx{tx}}
 	a.SetAnteCache(ac)
 
-	a.evict(tx)
+	a.evict(tx, EvictReasonRecheck)
 
 	if ac.Exists(addr, nonce) {
 		t.Fatal("evict must clear the tx's ante-cache entry, not leak it")
@@ -95,7 +95,7 @@ func evictNilAnteCacheNoPanic(t *testing.T) {
 	a := newManager(&stubRunner{}, nil, noopEncoder, nil)
 	a.mpool = &fakePool{txs: []sdk.Tx{tx}}
 
-	a.evict(tx) // anteCache nil: must be a no-op, not a panic
+	a.evict(tx, EvictReasonRecheck) // anteCache nil: must be a no-op, not a panic
 }
 
 func evictNonEthMsgSkipsAnteCache(t *testing.T) {
@@ -105,7 +105,7 @@ func evictNonEthMsgSkipsAnteCache(t *testing.T) {
 	a.mpool = &fakePool{txs: []sdk.Tx{tx}}
 	a.SetAnteCache(antecache.NewAnteCache(0))
 
-	a.evict(tx)
+	a.evict(tx, EvictReasonRecheck)
 }
 
 func evictTTLEvictionClearsAnteCache(t *testing.T) {
@@ -184,7 +184,7 @@ func evictMultipleMsgsClearsAll(t *testing.T) {
 
 	multi := &multiMsgTx{msgs: []sdk.Msg{msg1.msg, msg2.msg}}
 	a.mpool = &fakePool{txs: []sdk.Tx{multi}}
-	a.evict(multi)
+	a.evict(multi, EvictReasonRecheck)
 
 	if ac.Exists(addr1, nonce1) || ac.Exists(addr2, nonce2) {
 		t.Fatal("evict must clear every eth message's ante-cache entry")
@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -19,6 +20,20 @@ import (
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
+// Eviction reasons reported to the EvictionListener.
+const (
+	// EvictReasonExpired marks a tx evicted by its own timeout height or timestamp.
+	EvictReasonExpired = "expired"
+	// EvictReasonTTL marks a tx evicted after staying in the pool for ttlNumBlocks.
+	EvictReasonTTL = "ttl"
+	// EvictReasonRecheck marks a tx evicted because it failed the recheck.
+	EvictReasonRecheck = "recheck"
+)
+
+// EvictionListener is called for every tx evicted from the pool with the tx signers and the
+// eviction reason. It's called synchronously on the recheck path, so it must not block.
+type EvictionListener func(tx sdk.Tx, signers []string, reason string)
+
 type txRunner interface {
 	RunTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx, txIndex int, txMultiStore storetypes.MultiStore, incarnationCache map[string]any) (sdk.GasInfo, *sdk.Result, []abci.Event, error)
 }
@@ -69,6 +84,8 @@ type Manager struct {
 	recheckDisabled bool
 	// pendingTxCache avoids re-walking the pool on every PendingTxs() call.
 	pendingTxCache pendingTxCache
+	// onEvict is notified of every eviction; nil = off.
+	onEvict EvictionListener
 }
 
 // NewManager builds the Manager for mempool.type=app;
@@ -166,6 +183,11 @@ func (a *Manager) SetAnteCache(ac *antecache.AnteCache) {
 	a.anteCache = ac
 }
 
+// SetEvictionListener sets the listener notified of every evicted tx.
+func (a *Manager) SetEvictionListener(fn EvictionListener) {
+	a.onEvict = fn
+}
+
 // InsertTxHandler validates peer-relayed txs via RunTx(ExecModeCheck) before
 // admitting them.
 func (a *Manager) InsertTxHandler() sdk.InsertTxHandler {
@@ -191,6 +213,58 @@ func (a *Manager) PendingTxs() []sdk.Tx {
 	})
 }
 
+// SenderStatus is the pool view of a sender.
+type SenderStatus struct {
+	// Nonces are the sequences of the pooled txs of the sender, in ascending order.
+	Nonces []uint64
+	// RecheckStaged reports whether the sender is staged in recheckSenders for the next recheck.
+	RecheckStaged bool
+	// Deferred reports whether a tx of the sender is carried over to the next recheck.
+	Deferred bool
+}
+
+// SenderStatus returns the pool view of the sender, identified by its signer string, the pooled nonces
+// come from the sender index which is rebuilt once per pool change instead of walking the pool on each call.
+func (a *Manager) SenderStatus(sender string) SenderStatus {
+	var status SenderStatus
+	if a.signer == nil {
+		return status
+	}
+	status.Nonces = slices.Clone(a.pendingTxCache.senderIndex(a.buildSenderIndex)[sender])
+
+	a.stagingMu.Lock()
+	_, status.RecheckStaged = a.recheckSenders[sender]
+	// the carry is replaced, never mutated in place, so it's safe to scan after unlock.
+	deferred := a.deferred
+	a.stagingMu.Unlock()
+	for _, tx := range deferred {
+		if slices.Contains(a.signers(tx), sender) {
+			status.Deferred = true
+			break
+		}
+	}
+	return status
+}
+
+// buildSenderIndex indexes the sequences of the pooled txs by signer, in ascending order.
+func (a *Manager) buildSenderIndex() map[string][]uint64 {
+	index := make(map[string][]uint64)
+	for _, tx := range a.PendingTxs() {
+		sigs, err := a.signer.GetSigners(tx)
+		if err != nil {
+			continue
+		}
+		for _, s := range sigs {
+			signer := s.Signer.String()
+			index[signer] = append(index[signer], s.Sequence)
+		}
+	}
+	for _, nonces := range index {
+		slices.Sort(nonces)
+	}
+	return index
+}
+
 func (a *Manager) CountTx() int {
 	if a.mpool == nil {
 		return 0
@@ -410,14 +484,14 @@ func (a *Manager) selectTxs(snapshot []sdk.Tx, recheckSenders map[string]struct{
 	now := time.Now()
 	for _, tx := range snapshot {
 		if txTimedout(tx, height, now) {
-			evictedSet, recheckSenders = a.evictForRecheck(tx, evictedSet, recheckSenders)
+			evictedSet, recheckSenders = a.evictForRecheck(tx, EvictReasonExpired, evictedSet, recheckSenders)
 			expiredEvicted++
 			continue
 		}
 		if a.ttlNumBlocks > 0 {
 			arrived, expired := txTTLExpired(a.arrival, tx, height, a.ttlNumBlocks)
 			if expired {
-				evictedSet, recheckSenders = a.evictForRecheck(tx, evictedSet, recheckSenders)
+				evictedSet, recheckSenders = a.evictForRecheck(tx, EvictReasonTTL, evictedSet, recheckSenders)
 				ttlEvicted++
 				continue
 			}
@@ -476,8 +550,8 @@ func (a *Manager) selectTxs(snapshot []sdk.Tx, recheckSenders map[string]struct{
 
 // evictForRecheck evicts tx and folds its signers into recheckSenders, allocating
 // evictedSet/recheckSenders lazily so a no-eviction cycle stays alloc-free.
-func (a *Manager) evictForRecheck(tx sdk.Tx, evictedSet map[sdk.Tx]struct{}, recheckSenders map[string]struct{}) (map[sdk.Tx]struct{}, map[string]struct{}) {
-	a.evict(tx)
+func (a *Manager) evictForRecheck(tx sdk.Tx, reason string, evictedSet map[sdk.Tx]struct{}, recheckSenders map[string]struct{}) (map[sdk.Tx]struct{}, map[string]struct{}) {
+	a.evict(tx, reason)
 	if evictedSet == nil {
 		evictedSet = make(map[sdk.Tx]struct{})
 	}
@@ -517,7 +591,7 @@ func (a *Manager) runRecheck(candidates []sdk.Tx) {
 		_, _, _, err = a.runner.RunTx(sdk.ExecModeReCheck, bz, tx, -1, nil, nil)
 		a.mu.Unlock()
 		if err != nil {
-			a.evict(tx)
+			a.evict(tx, EvictReasonRecheck)
 			evicted++
 		}
 	}
@@ -554,10 +628,13 @@ func txTTLExpired(arrival map[sdk.Tx]int64, tx sdk.Tx, height, ttlNumBlocks int6
 
 // evict removes tx from the pool, encoder cache, and ante cache together, so
 // no cache outlives its pool entry.
-func (a *Manager) evict(tx sdk.Tx) {
+func (a *Manager) evict(tx sdk.Tx, reason string) {
 	_ = a.mpool.Remove(tx)
 	a.encCache.Evict(tx)
 	a.evictAnteCache(tx)
+	if a.onEvict != nil {
+		a.onEvict(tx, a.signers(tx), reason)
+	}
 }
 
 func (a *Manager) evictAnteCache(tx sdk.Tx) {
@@ -18,6 +18,12 @@ type pendingTxCache struct {
 	loaded      bool
 	loadedEpoch uint64
 	epoch       atomic.Uint64
+
+	// indexMu guards the sender index, separate from mu since the index is built from a get() snapshot.
+	indexMu     sync.Mutex
+	index       map[string][]uint64
+	indexLoaded bool
+	indexEpoch  uint64
 }
 
 func (c *pendingTxCache) get(load func() []sdk.Tx) []sdk.Tx {
@@ -45,6 +51,20 @@ func (c *pendingTxCache) copySnapshot() []sdk.Tx {
 	return out
 }
 
+// senderIndex returns the pooled nonces by sender, the index is built once per epoch whether the
+// snapshot cache is enabled or not. The returned map is shared, callers must not mutate it.
+func (c *pendingTxCache) senderIndex(build func() map[string][]uint64) map[string][]uint64 {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+
+	epoch := c.epoch.Load()
+	if c.indexLoaded && c.indexEpoch == epoch {
+		return c.index
+	}
+	c.index, c.indexLoaded, c.indexEpoch = build(), true, epoch
+	return c.index
+}
+
 // invalidate marks the snapshot stale.
 func (c *pendingTxCache) invalidate() {
 	c.epoch.Add(1)
@@ -3,6 +3,7 @@ package mempool
 import (
 	"context"
 	"errors"
+	"slices"
 	"strconv"
 	"sync"
 	"testing"
@@ -992,3 +993,75 @@ func TestRecheckTxs_NilEncCacheEvictionNoPanic(t *testing.T) {
 		t.Fatal("aged tx must be evicted even with nil encCache")
 	}
 }
+
+// The eviction listener sees every eviction with the signers and the reason.
+func TestRecheckTxs_EvictionListenerReasons(t *testing.T) {
+	f := newRecheckFixture("alice-0") // alice's seq-0 fails recheck
+	f.a.ttlNumBlocks = 5
+	reasons := map[string]string{}
+	f.a.SetEvictionListener(func(_ sdk.Tx, signers []string, reason string) {
+		for _, s := range signers {
+			reasons[s] = reason
+		}
+	})
+	f.add(1, "alice", 0, "alice-0")
+	f.add(2, "bob", 0, "bob-0")
+	f.addTimeout(3, "carol", 0, "carol-0", 12)
+
+	f.a.lastCommittedHeight = 10 // arrival=10 for all
+	f.a.RecheckTxs()
+	if len(reasons) != 0 {
+		t.Fatalf("no eviction expected on first sighting, got %v", reasons)
+	}
+
+	f.a.recheckSenders = map[string]struct{}{sdk.AccAddress("alice").String(): {}}
+	f.a.lastCommittedHeight = 12 // carol timed out, alice rechecked
+	f.a.RecheckTxs()
+	f.a.lastCommittedHeight = 15 // bob aged past ttl
+	f.a.RecheckTxs()
+
+	expected := map[string]string{
+		sdk.AccAddress("alice").String(): EvictReasonRecheck,
+		sdk.AccAddress("bob").String():   EvictReasonTTL,
+		sdk.AccAddress("carol").String(): EvictReasonExpired,
+	}
+	for sender, reason := range expected {
+		if reasons[sender] != reason {
+			t.Fatalf("sender %s: expected reason %q, got %q", sender, reason, reasons[sender])
+		}
+	}
+}
+
+// SenderStatus reports the pooled nonces and the recheck staging of the sender.
+func TestSenderStatus(t *testing.T) {
+	f := newRecheckFixture()
+	f.add(1, "alice", 3, "alice-3")
+	f.add(2, "alice", 1, "alice-1")
+	bob := f.add(3, "bob", 0, "bob-0")
+	alice := sdk.AccAddress("alice").String()
+
+	status := f.a.SenderStatus(alice)
+	if !slices.Equal(status.Nonces, []uint64{1, 3}) {
+		t.Fatalf("expected pooled nonces [1 3], got %v", status.Nonces)
+	}
+	if status.RecheckStaged || status.Deferred {
+		t.Fatal("sender must not be staged for recheck")
+	}
+
+	f.a.recheckSenders = map[string]struct{}{alice: {}}
+	f.a.deferred = []sdk.Tx{bob}
+	status = f.a.SenderStatus(alice)
+	if !status.RecheckStaged || status.Deferred {
+		t.Fatalf("expected staged and not deferred, got %+v", status)
+	}
+	if !f.a.SenderStatus(sdk.AccAddress("bob").String()).Deferred {
+		t.Fatal("bob must be reported as deferred")
+	}
+
+	// the sender index is rebuilt after the pool changes
+	f.add(4, "alice", 2, "alice-2")
+	f.a.pendingTxCache.invalidate()
+	if nonces := f.a.SenderStatus(alice).Nonces; !slices.Equal(nonces, []uint64{1, 2, 3}) {
+		t.Fatalf("expected pooled nonces [1 2 3], got %v", nonces)
+	}
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	cronosmempool "github.com/crypto-org-chain/cronos/app/mempool"
+	cronosrpc "github.com/crypto-org-chain/cronos/x/cronos/rpc"
+	antecache "github.com/evmos/ethermint/ante/cache"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ cronosrpc.AppMempool = rpcMempool{}
+
+// rpcMempool exposes the app-side mempool and the proposal gate stats to the cronos json-rpc namespace.
+type rpcMempool struct {
+	manager *cronosmempool.Manager
+	// proposal is nil when the fast PrepareProposal path is disabled, the baseFee gate is off then.
+	proposal    *MempoolProposalHandler
+	txsPerBlock int
+	anteCache   *antecache.AnteCache
+	blocklist   *ProposalHandler
+}
+
+func (m rpcMempool) PendingTxs() []sdk.Tx {
+	return m.manager.PendingTxs()
+}
+
+func (m rpcMempool) MaxTxPerBlock() int {
+	return m.txsPerBlock
+}
+
+func (m rpcMempool) GateSkipStats() (skipped, proposals int) {
+	if m.proposal == nil {
+		return 0, 0
+	}
+	return m.proposal.GateSkipStats()
+}
+
+func (m rpcMempool) SenderStatus(sender string) cronosrpc.MempoolSenderStatus {
+	status := m.manager.SenderStatus(sender)
+	return cronosrpc.MempoolSenderStatus{
+		Nonces:        status.Nonces,
+		RecheckStaged: status.RecheckStaged,
+		Deferred:      status.Deferred,
+	}
+}
+
+func (m rpcMempool) AnteCached(sender string, nonce uint64) bool {
+	if m.anteCache == nil {
+		return false
+	}
+	return m.anteCache.Exists(sender, nonce)
+}
+
+func (m rpcMempool) IsBlocked(sender string) (blocked, known bool) {
+	return m.blocklist.IsBlocked(sender)
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"sync"
 
 	"filippo.io/age"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cronosmempool "github.com/crypto-org-chain/cronos/app/mempool"
+	"github.com/crypto-org-chain/cronos/x/e2ee/threshold"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"cosmossdk.io/core/address"
@@ -25,6 +27,9 @@ import (
 
 type BlockList struct {
 	Addresses []string `mapstructure:"addresses"`
+	// ThresholdList replaces the addresses with a block list encrypted t-of-n to the validators, it's decrypted
+	// from the partial decryptions the validators exchange sealed in the vote extensions.
+	ThresholdList *threshold.List `mapstructure:"threshold_list" json:"threshold_list,omitempty"`
 }
 
 var _ baseapp.TxSelector = &ExtTxSelector{}
@@ -126,11 +131,19 @@ func (ts *ExtTxSelector) gateBaseFee(goCtx context.Context) (*big.Int, string) {
 	return ts.baseFee, ts.evmDenom
 }
 
+// gateStatsWindow is the number of recent proposals covered by GateSkipStats.
+const gateStatsWindow = 20
+
 // MempoolProposalHandler defines a custom PrepareProposal for cronos.
 type MempoolProposalHandler struct {
 	mempoolManager *cronosmempool.Manager
 	extSel         *ExtTxSelector
 	inner          sdk.PrepareProposalHandler
+
+	// gateStatsMu guards gateSkips, the baseFee gate skip counts of the recent proposals,
+	// read concurrently by the json-rpc fee suggestion.
+	gateStatsMu sync.Mutex
+	gateSkips   []int
 }
 
 // NewMempoolProposalHandler wraps h with the blocklist + baseFee gate ExtTxSelector.
@@ -157,6 +170,7 @@ func (h *MempoolProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 		}
 		resp, err := h.inner(ctx, req)
 		skipped := h.extSel.DrainGateSkipped() // drain every round, even on error, so it never goes stale
+		h.recordGateSkips(len(skipped))
 		if err == nil && h.mempoolManager != nil && len(skipped) > 0 {
 			h.mempoolManager.StageSkippedSenders(skipped)
 		}
@@ -164,6 +178,27 @@ func (h *MempoolProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 	}
 }
 
+// recordGateSkips records the gate skip count of a proposal, keeping the last gateStatsWindow ones.
+func (h *MempoolProposalHandler) recordGateSkips(n int) {
+	h.gateStatsMu.Lock()
+	defer h.gateStatsMu.Unlock()
+	h.gateSkips = append(h.gateSkips, n)
+	if len(h.gateSkips) > gateStatsWindow {
+		h.gateSkips = h.gateSkips[len(h.gateSkips)-gateStatsWindow:]
+	}
+}
+
+// GateSkipStats returns the number of txs skipped by the baseFee gate in the recent proposals
+// prepared by this node, and the number of these proposals.
+func (h *MempoolProposalHandler) GateSkipStats() (skipped, proposals int) {
+	h.gateStatsMu.Lock()
+	defer h.gateStatsMu.Unlock()
+	for _, n := range h.gateSkips {
+		skipped += n
+	}
+	return skipped, len(h.gateSkips)
+}
+
 var _ baseapp.ProposalTxVerifier = &CacheProposalTxVerifier{}
 
 // CacheProposalTxVerifier is used to cache encoded transactions to avoid cpu overhead during proposal.
@@ -190,11 +225,17 @@ func (txv *CacheProposalTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte,
 
 type ProposalHandler struct {
 	TxDecoder sdk.TxDecoder
-	// Identity is nil if it's not a validator node
-	Identity      age.Identity
+	// Identity is nil if it's not a validator node, it's rotated at runtime by the identity reloader.
+	Identity age.Identity
+	// blocklistMu guards the swaps of blocklist against the reads in IsBlocked from the json-rpc and in
+	// ValidateTransaction, the other reads happen in the abci calls, serialized with the swaps.
+	blocklistMu   sync.RWMutex
 	blocklist     map[string]struct{}
 	lastBlockList []byte
 	addressCodec  address.Codec
+	// pending is the threshold block list waiting for the partial decryptions, the active block list is kept
+	// until it's decrypted and switched to.
+	pending *pendingThresholdList
 }
 
 func NewProposalHandler(txDecoder sdk.TxDecoder, identity age.Identity, addressCodec address.Codec) *ProposalHandler {
@@ -217,8 +258,11 @@ func (h *ProposalHandler) SetBlockList(blob []byte) error {
 	}
 
 	if len(blob) == 0 {
+		h.blocklistMu.Lock()
 		h.blocklist = make(map[string]struct{})
+		h.blocklistMu.Unlock()
 		h.lastBlockList = nil
+		h.pending = nil
 		return nil
 	}
 
@@ -237,9 +281,32 @@ func (h *ProposalHandler) SetBlockList(blob []byte) error {
 		return err
 	}
 
+	if blocklist.ThresholdList != nil {
+		if err := blocklist.ThresholdList.Validate(); err != nil {
+			return err
+		}
+		pending, err := newPendingThresholdList(blocklist.ThresholdList, blob, h.Identity)
+		if err != nil {
+			return err
+		}
+		h.pending = pending
+	} else {
+		if err := h.setAddresses(blocklist.Addresses); err != nil {
+			return err
+		}
+		h.pending = nil
+	}
+
+	h.lastBlockList = make([]byte, len(blob))
+	copy(h.lastBlockList, blob)
+	return nil
+}
+
+// setAddresses replaces the active block list.
+func (h *ProposalHandler) setAddresses(addresses []string) error {
 	// convert to map
-	m := make(map[string]struct{}, len(blocklist.Addresses))
-	for _, s := range blocklist.Addresses {
+	m := make(map[string]struct{}, len(addresses))
+	for _, s := range addresses {
 		addr, err := h.addressCodec.StringToBytes(s)
 		if err != nil {
 			return fmt.Errorf("invalid bech32 address: %s, err: %w", s, err)
@@ -254,13 +321,28 @@ func (h *ProposalHandler) SetBlockList(blob []byte) error {
 		m[encoded] = struct{}{}
 	}
 
+	h.blocklistMu.Lock()
 	h.blocklist = m
-	h.lastBlockList = make([]byte, len(blob))
-	copy(h.lastBlockList, blob)
+	h.blocklistMu.Unlock()
 	return nil
 }
 
+// IsBlocked reports whether the bech32 address is in the block list, known is false if the node can't
+// decrypt the block list.
+func (h *ProposalHandler) IsBlocked(addr string) (blocked, known bool) {
+	if h.Identity == nil {
+		return false, false
+	}
+	h.blocklistMu.RLock()
+	defer h.blocklistMu.RUnlock()
+	_, blocked = h.blocklist[addr]
+	return blocked, true
+}
+
 func (h *ProposalHandler) ValidateTransaction(tx sdk.Tx, txBz []byte) error {
+	h.blocklistMu.RLock()
+	defer h.blocklistMu.RUnlock()
+
 	if len(h.blocklist) == 0 {
 		// fast path, accept all txs
 		return nil
@@ -338,12 +420,22 @@ func (h *ProposalHandler) ValidateTransaction(tx sdk.Tx, txBz []byte) error {
 
 func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		txs := req.Txs
+		// the switch to the threshold block list, applied when the block is finalized.
+		_, ok, err := parseThresholdSwitch(txs)
+		if err != nil {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		if ok {
+			txs = txs[1:]
+		}
+
 		if len(h.blocklist) == 0 {
 			// fast path, accept all txs
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 		}
 
-		for _, txBz := range req.Txs {
+		for _, txBz := range txs {
 			if err := h.ValidateTransaction(nil, txBz); err != nil {
 				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 			}
@@ -352,12 +444,3 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
 }
-
-// noneIdentity is a dummy identity which postpone the failure to the decryption time
-type noneIdentity struct{}
-
-var _ age.Identity = noneIdentity{}
-
-func (noneIdentity) Unwrap([]*age.Stanza) ([]byte, error) {
-	return nil, age.ErrIncorrectIdentity
-}
@@ -199,6 +199,21 @@ func TestSetBlockListRetriesAfterFailure(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestGateSkipStats(t *testing.T) {
+	h := &MempoolProposalHandler{}
+	skipped, proposals := h.GateSkipStats()
+	require.Zero(t, skipped)
+	require.Zero(t, proposals)
+
+	for i := 0; i < gateStatsWindow+5; i++ {
+		h.recordGateSkips(i)
+	}
+	// only the last gateStatsWindow proposals are counted: 5..24
+	skipped, proposals = h.GateSkipStats()
+	require.Equal(t, gateStatsWindow, proposals)
+	require.Equal(t, (5+gateStatsWindow+4)*gateStatsWindow/2, skipped)
+}
+
 func TestProtoSizeForTx(t *testing.T) {
 	for _, n := range []int{0, 1, 2, 127, 128, 129, 300, 16383, 16384, 16385, 70000} {
 		bz := make([]byte, n)
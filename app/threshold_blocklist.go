@@ -0,0 +1,359 @@
+package app
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"filippo.io/age"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/crypto-org-chain/cronos/x/e2ee/threshold"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxShareExtensionSize caps the vote extension carrying the sealed partial decryption of the block list, it fits
+// the partial decryption sealed to the sessions of threshold.MaxShares validators.
+const MaxShareExtensionSize = 64 * 1024
+
+// thresholdApplyPrefix starts the pseudo tx switching the validators to the threshold block list, followed by the
+// digest of the block list blob. It carries no partial decryption, it never decodes as a tx, so it's only consumed
+// by the block list handlers and fails in the tx execution.
+var thresholdApplyPrefix = []byte("cronos-threshold-apply:")
+
+// ShareExtension is the vote extension exchanging the partial decryptions of the pending threshold block list. The
+// partial decryptions are only sealed to the in-memory session keys of the validators, so they are never in
+// plaintext in the gossiped votes or the stored commits, and a leaked e2ee identity alone can't collect them.
+type ShareExtension struct {
+	// ListDigest is the sha256 of the block list blob the partial decryption belongs to.
+	ListDigest []byte `json:"list_digest"`
+	// Session is the X25519 recipient of the session identity the validator generated for the pending block list,
+	// it's only kept in memory, so a restarted validator publishes a new one.
+	Session string `json:"session"`
+	// Ready is true once the validator switched to the block list.
+	Ready bool `json:"ready"`
+	// Sealed is the partial decryption of the validator sealed to the sessions of the validators not ready yet,
+	// empty if all of them are ready or the validator holds no share.
+	Sealed []byte `json:"sealed,omitempty"`
+}
+
+// pendingThresholdList is the threshold encrypted block list waiting for enough partial decryptions, the state is
+// only kept in memory and rebuilt from the block list blob after a restart.
+type pendingThresholdList struct {
+	list   *threshold.List
+	digest []byte
+	// session opens the partial decryptions sealed by the other validators.
+	session *age.X25519Identity
+	// own is the partial decryption with the share decrypted by the local identity, nil if the validator holds
+	// no share.
+	own      *threshold.Partial
+	partials map[byte]threshold.Partial
+	// peers are the sessions of the validators not ready yet by consensus address, the own partial decryption is
+	// sealed to them.
+	peers map[string]*age.X25519Recipient
+	// addresses is the decrypted block list, set once decrypted.
+	addresses []string
+	decrypted bool
+	// activated is set by the switch in a finalized block, the block list is applied once it's also decrypted.
+	activated bool
+	applied   bool
+}
+
+func newPendingThresholdList(list *threshold.List, blob []byte, identity age.Identity) (*pendingThresholdList, error) {
+	session, err := age.GenerateX25519Identity()
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(blob)
+	pending := &pendingThresholdList{
+		list:     list,
+		digest:   digest[:],
+		session:  session,
+		partials: make(map[byte]threshold.Partial),
+		peers:    make(map[string]*age.X25519Recipient),
+	}
+	share, err := list.DecryptShare(identity)
+	if err != nil {
+		// not a share holder, the block list is decrypted from the partial decryptions of the others.
+		return pending, nil
+	}
+	partial, err := list.PartialDecrypt(share)
+	if err != nil {
+		return nil, err
+	}
+	pending.own = &partial
+	if err := pending.addPartial(partial); err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// addPartial collects a verified partial decryption, the block list is decrypted once they reach the threshold.
+func (p *pendingThresholdList) addPartial(partial threshold.Partial) error {
+	if p.decrypted {
+		return nil
+	}
+	if _, ok := p.partials[partial.Index]; ok || !p.list.VerifyPartial(partial) {
+		return nil
+	}
+	p.partials[partial.Index] = partial
+	if len(p.partials) < p.list.Threshold {
+		return nil
+	}
+	partials := slices.SortedFunc(maps.Values(p.partials), func(a, b threshold.Partial) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	addresses, err := decryptThresholdList(p.list, partials)
+	if err != nil {
+		return err
+	}
+	p.addresses = addresses
+	p.decrypted = true
+	return nil
+}
+
+// seal encrypts the own partial decryption to the sessions of the validators not ready yet.
+func (p *pendingThresholdList) seal() ([]byte, error) {
+	if p.own == nil || len(p.peers) == 0 {
+		return nil, nil
+	}
+	recipients := make([]age.Recipient, 0, len(p.peers))
+	for _, validator := range slices.Sorted(maps.Keys(p.peers)) {
+		if len(recipients) == threshold.MaxShares {
+			break
+		}
+		recipients = append(recipients, p.peers[validator])
+	}
+	plaintext, err := json.Marshal(p.own)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// open decrypts the partial decryption sealed to the session, it fails if it's not sealed to it.
+func (p *pendingThresholdList) open(sealed []byte) (threshold.Partial, error) {
+	r, err := age.Decrypt(bytes.NewReader(sealed), p.session)
+	if err != nil {
+		return threshold.Partial{}, err
+	}
+	plaintext, err := io.ReadAll(r)
+	if err != nil {
+		return threshold.Partial{}, err
+	}
+	var partial threshold.Partial
+	if err := json.Unmarshal(plaintext, &partial); err != nil {
+		return threshold.Partial{}, err
+	}
+	return partial, nil
+}
+
+// ExtendVoteHandler publishes the session and the sealed partial decryption of the pending threshold block list in
+// the vote extension, nothing is encrypted to the chain registered keys, so no plugin runs in the consensus path.
+// The vote extensions are enabled in the consensus params by the v1.8 upgrade.
+func (h *ProposalHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		pending := h.pending
+		if pending == nil {
+			return &abci.ResponseExtendVote{}, nil
+		}
+		ext := ShareExtension{
+			ListDigest: pending.digest,
+			Session:    pending.session.Recipient().String(),
+			Ready:      pending.applied,
+		}
+		sealed, err := pending.seal()
+		if err != nil {
+			ctx.Logger().Error("failed to seal the block list partial decryption", "error", err)
+		}
+		ext.Sealed = sealed
+		bz, err := json.Marshal(ext)
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler checks the format of the share extension, and collects the partial decryption if it's
+// sealed to the local session, it's verified against the commitments in the block list. The extensions of the
+// validators not ready yet are the only ones carrying their sessions, so the restarted validators catch up.
+func (h *ProposalHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+		ext, session, err := parseShareExtension(req.VoteExtension)
+		if err != nil {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		if err := h.collectShare(req.ValidatorAddress, ext, session); err != nil {
+			ctx.Logger().Error("failed to decrypt the threshold block list", "error", err)
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// parseShareExtension decodes the share extension, the session is nil if the validator is ready.
+func parseShareExtension(bz []byte) (ShareExtension, *age.X25519Recipient, error) {
+	var ext ShareExtension
+	if len(bz) > MaxShareExtensionSize {
+		return ext, nil, fmt.Errorf("share extension too large, %d bytes", len(bz))
+	}
+	if err := json.Unmarshal(bz, &ext); err != nil {
+		return ext, nil, err
+	}
+	if ext.Ready {
+		return ext, nil, nil
+	}
+	session, err := age.ParseX25519Recipient(ext.Session)
+	if err != nil {
+		return ext, nil, err
+	}
+	return ext, session, nil
+}
+
+// collectShare records the readiness of the validator, and collects the partial decryption sealed to the local
+// session.
+func (h *ProposalHandler) collectShare(validator []byte, ext ShareExtension, session *age.X25519Recipient) error {
+	pending := h.pending
+	if pending == nil || !bytes.Equal(ext.ListDigest, pending.digest) {
+		return nil
+	}
+	if session == nil {
+		delete(pending.peers, string(validator))
+	} else {
+		pending.peers[string(validator)] = session
+	}
+	if pending.decrypted || len(ext.Sealed) == 0 {
+		return nil
+	}
+	partial, err := pending.open(ext.Sealed)
+	if err != nil {
+		// sealed to the other validators only
+		return nil
+	}
+	if err := pending.addPartial(partial); err != nil {
+		return err
+	}
+	return h.applyThresholdList()
+}
+
+// ProposeThresholdList wraps the PrepareProposal handler to switch the validators to the threshold block list once
+// the proposer decrypted it. The switch is proposed again while some validators of the last commit are not ready,
+// so the validators restarted since, which lost the in-memory block list, switch again once they catch up. The block
+// list is applied when the block is finalized, see ApplyThresholdList.
+func (h *ProposalHandler) ProposeThresholdList(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		switchTx := h.thresholdSwitch(req.LocalLastCommit.Votes)
+		if switchTx == nil || int64(len(switchTx)) > req.MaxTxBytes {
+			return next(ctx, req)
+		}
+
+		inner := *req
+		inner.MaxTxBytes -= int64(len(switchTx))
+		rsp, err := next(ctx, &inner)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Txs = append([][]byte{switchTx}, rsp.Txs...)
+		return rsp, nil
+	}
+}
+
+// thresholdSwitch returns the pseudo tx switching to the threshold block list, nil unless the proposer decrypted it
+// and it's not activated yet or some validators are not ready.
+func (h *ProposalHandler) thresholdSwitch(votes []abci.ExtendedVoteInfo) []byte {
+	pending := h.pending
+	if pending == nil || !pending.decrypted {
+		return nil
+	}
+	ready := pending.activated
+	for _, vote := range votes {
+		if len(vote.VoteExtension) == 0 {
+			continue
+		}
+		ext, _, err := parseShareExtension(vote.VoteExtension)
+		if err == nil && bytes.Equal(ext.ListDigest, pending.digest) && !ext.Ready {
+			ready = false
+		}
+	}
+	if ready {
+		return nil
+	}
+	return append(slices.Clone(thresholdApplyPrefix), pending.digest...)
+}
+
+// parseThresholdSwitch returns the digest of the block list switched to by the proposal, ok is false if there's no
+// switch.
+func parseThresholdSwitch(txs [][]byte) (digest []byte, ok bool, err error) {
+	if len(txs) == 0 || !bytes.HasPrefix(txs[0], thresholdApplyPrefix) {
+		return nil, false, nil
+	}
+	digest = txs[0][len(thresholdApplyPrefix):]
+	if len(digest) != sha256.Size {
+		return nil, false, fmt.Errorf("invalid threshold block list digest length %d", len(digest))
+	}
+	return digest, true, nil
+}
+
+// ApplyThresholdList activates the threshold block list switched to by the finalized block, it's called before the
+// block execution so all the validators having decrypted it apply it from the same height, the others apply it once
+// they decrypt it.
+func (h *ProposalHandler) ApplyThresholdList(txs [][]byte) error {
+	digest, ok, err := parseThresholdSwitch(txs)
+	if err != nil || !ok {
+		return err
+	}
+	if h.pending == nil || !bytes.Equal(digest, h.pending.digest) {
+		return nil
+	}
+	h.pending.activated = true
+	return h.applyThresholdList()
+}
+
+// applyThresholdList switches to the pending block list once it's both activated and decrypted.
+func (h *ProposalHandler) applyThresholdList() error {
+	pending := h.pending
+	if !pending.activated || !pending.decrypted || pending.applied {
+		return nil
+	}
+	if err := h.setAddresses(pending.addresses); err != nil {
+		return err
+	}
+	pending.applied = true
+	return nil
+}
+
+// decryptThresholdList combines the partial decryptions and decodes the block list.
+func decryptThresholdList(list *threshold.List, partials []threshold.Partial) ([]string, error) {
+	plaintext, err := list.Decrypt(partials)
+	if err != nil {
+		return nil, err
+	}
+	var blocklist BlockList
+	if err := json.Unmarshal(plaintext, &blocklist); err != nil {
+		return nil, fmt.Errorf("invalid threshold block list: %w", err)
+	}
+	if blocklist.ThresholdList != nil {
+		return nil, errors.New("nested threshold block list")
+	}
+	return blocklist.Addresses, nil
+}
@@ -0,0 +1,182 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"filippo.io/age"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/crypto-org-chain/cronos/x/e2ee/threshold"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestThresholdBlockList(t *testing.T) {
+	addressCodec := authcodec.NewBech32Codec("cosmos")
+	blocked, err := addressCodec.BytesToString(bytes.Repeat([]byte{0x1}, 20))
+	require.NoError(t, err)
+
+	handlers := make([]*ProposalHandler, 3)
+	identities := make([]*age.X25519Identity, len(handlers))
+	recipients := make([]age.Recipient, len(handlers))
+	for i := range handlers {
+		identities[i], err = age.GenerateX25519Identity()
+		require.NoError(t, err)
+		handlers[i] = NewProposalHandler(nil, identities[i], addressCodec)
+		recipients[i] = identities[i].Recipient()
+	}
+
+	body, err := json.Marshal(BlockList{Addresses: []string{blocked}})
+	require.NoError(t, err)
+	list, err := threshold.Encrypt(body, recipients, 2)
+	require.NoError(t, err)
+	body, err = json.Marshal(BlockList{ThresholdList: list})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients...)
+	require.NoError(t, err)
+	_, err = w.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	blob := buf.Bytes()
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	validator := func(i int) []byte { return []byte{byte(i)} }
+	extend := func(h *ProposalHandler) ShareExtension {
+		rsp, err := h.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{})
+		require.NoError(t, err)
+		ext, _, err := parseShareExtension(rsp.VoteExtension)
+		require.NoError(t, err)
+		return ext
+	}
+	// exchange the vote extensions of a height, each validator verifies the others.
+	exchange := func() []abci.ExtendedVoteInfo {
+		votes := make([]abci.ExtendedVoteInfo, len(handlers))
+		for i, h := range handlers {
+			bz, err := json.Marshal(extend(h))
+			require.NoError(t, err)
+			votes[i] = abci.ExtendedVoteInfo{Validator: abci.Validator{Address: validator(i)}, VoteExtension: bz}
+		}
+		for i, h := range handlers {
+			for j, vote := range votes {
+				if i == j {
+					continue
+				}
+				rsp, err := h.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{
+					ValidatorAddress: validator(j),
+					VoteExtension:    vote.VoteExtension,
+				})
+				require.NoError(t, err)
+				require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, rsp.Status)
+			}
+		}
+		return votes
+	}
+
+	tx := []byte("tx")
+	prepare := func(h *ProposalHandler, votes []abci.ExtendedVoteInfo) [][]byte {
+		next := func(sdk.Context, *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			return &abci.ResponsePrepareProposal{Txs: [][]byte{tx}}, nil
+		}
+		rsp, err := h.ProposeThresholdList(next)(ctx, &abci.RequestPrepareProposal{
+			MaxTxBytes:      1 << 20,
+			LocalLastCommit: abci.ExtendedCommitInfo{Votes: votes},
+		})
+		require.NoError(t, err)
+		return rsp.Txs
+	}
+	process := func(h *ProposalHandler, txs [][]byte) abci.ResponseProcessProposal_ProposalStatus {
+		rsp, err := h.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: txs})
+		require.NoError(t, err)
+		return rsp.Status
+	}
+
+	for _, h := range handlers {
+		require.NoError(t, h.SetBlockList(blob))
+		// a single validator can't decrypt the block list
+		require.False(t, h.pending.decrypted)
+	}
+
+	// the sessions are published first, nothing is sealed before the sessions of the others are known
+	votes := exchange()
+	for _, vote := range votes {
+		var ext ShareExtension
+		require.NoError(t, json.Unmarshal(vote.VoteExtension, &ext))
+		require.False(t, ext.Ready)
+		require.Empty(t, ext.Sealed)
+	}
+	require.Equal(t, [][]byte{tx}, prepare(handlers[0], votes))
+
+	votes = exchange()
+	for i, vote := range votes {
+		var ext ShareExtension
+		require.NoError(t, json.Unmarshal(vote.VoteExtension, &ext))
+		require.NotEmpty(t, ext.Sealed)
+		// the partial decryption is only sealed to the sessions, not to the e2ee identities
+		for _, identity := range identities {
+			_, err := age.Decrypt(bytes.NewReader(ext.Sealed), identity)
+			require.Error(t, err)
+		}
+		require.True(t, handlers[i].pending.decrypted)
+		// no validator switches before the block is finalized
+		require.Empty(t, handlers[i].blocklist)
+	}
+
+	verified, err := handlers[0].VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: []byte("garbage")})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verified.Status)
+
+	txs := prepare(handlers[0], votes)
+	require.Len(t, txs, 2)
+	require.Equal(t, append(bytes.Clone(thresholdApplyPrefix), handlers[0].pending.digest...), txs[0])
+	require.Equal(t, tx, txs[1])
+
+	malformed := [][]byte{append(bytes.Clone(thresholdApplyPrefix), 1), tx}
+	for _, h := range handlers {
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, process(h, malformed))
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process(h, txs))
+	}
+
+	// all the validators switch at the same height
+	for _, h := range handlers {
+		require.NoError(t, h.ApplyThresholdList(txs))
+		blockedNow, known := h.IsBlocked(blocked)
+		require.True(t, known)
+		require.True(t, blockedNow)
+	}
+	// no more switch is proposed once all the validators are ready
+	votes = exchange()
+	require.Equal(t, [][]byte{tx}, prepare(handlers[1], votes))
+	for _, vote := range votes {
+		var ext ShareExtension
+		require.NoError(t, json.Unmarshal(vote.VoteExtension, &ext))
+		require.True(t, ext.Ready)
+	}
+
+	// a restarted validator lost the block list, the others seal to its new session and switch it again
+	handlers[2] = NewProposalHandler(nil, identities[2], addressCodec)
+	require.NoError(t, handlers[2].SetBlockList(blob))
+	exchange()
+	require.False(t, handlers[2].pending.decrypted)
+	votes = exchange()
+	require.True(t, handlers[2].pending.decrypted)
+	txs = prepare(handlers[0], votes)
+	require.Len(t, txs, 2)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process(handlers[2], txs))
+	for _, h := range handlers {
+		require.NoError(t, h.ApplyThresholdList(txs))
+	}
+	blockedNow, _ := handlers[2].IsBlocked(blocked)
+	require.True(t, blockedNow)
+	require.Equal(t, [][]byte{tx}, prepare(handlers[0], exchange()))
+
+	// a plain block list replaces the threshold one
+	require.NoError(t, handlers[0].SetBlockList(encryptBlockList(t, recipients[0])))
+	require.Nil(t, handlers[0].pending)
+	require.Empty(t, handlers[0].blocklist)
+}
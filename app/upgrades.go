@@ -3,11 +3,15 @@ package app
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"strings"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v11/modules/core/exported"
+	cronostypes "github.com/crypto-org-chain/cronos/x/cronos/types"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,6 +24,10 @@ import (
 
 const planName = "v1.8"
 
+// e2eePrecompileModuleVersion is the e2ee module version the e2ee precompiled contract is activated from, it's set
+// by the migrations of the v1.8 upgrade or the genesis of the new chains.
+const e2eePrecompileModuleVersion = 2
+
 // croBridgeContractAddresses are the EVM addresses of CroBridge contracts
 // authorized on Cronos mainnet. Empty list disables the SendCroToIbc hook.
 var croBridgeContractAddresses = []string{
@@ -68,15 +76,44 @@ func (app *App) RegisterUpgradeHandlers(cdc codec.BinaryCodec, maxVersion int64)
 			// where any contract emitting __CronosSendCroToIbc could drain CRO balances.
 			cronosParams := app.CronosKeeper.GetParams(sdkCtx)
 			cronosParams.CroBridgeContractAddresses = croBridgeContractAddresses
+			// Enable the EndBlock retries of the failed refund conversions.
+			cronosParams.MaxRefundRetryGas = cronostypes.MaxRefundRetryGasDefaultValue
 			if err := app.CronosKeeper.SetParams(sdkCtx, cronosParams); err != nil {
 				return toVM, fmt.Errorf("set cro bridge contract addresses: %w", err)
 			}
+			// The threshold block list exchanges the partial decryptions in the vote extensions.
+			if err := app.enableVoteExtensions(sdkCtx); err != nil {
+				return toVM, fmt.Errorf("enable vote extensions: %w", err)
+			}
 			return toVM, nil
 		},
 	)
 	return false
 }
 
+// enableVoteExtensions enables the vote extensions from the next block unless they are enabled already, the new
+// chains enable them in the genesis consensus params.
+func (app *App) enableVoteExtensions(ctx sdk.Context) error {
+	params, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Abci != nil && params.Abci.VoteExtensionsEnableHeight > 0 {
+		return nil
+	}
+	params.Abci = &cmtproto.ABCIParams{VoteExtensionsEnableHeight: ctx.BlockHeight() + 1}
+	return app.ConsensusParamsKeeper.ParamsStore.Set(ctx, params)
+}
+
+// e2eePrecompileEnabled returns if the e2ee precompiled contract is activated in the state of the context, the module
+// version is read from the state, so the replay of the blocks before the upgrade doesn't see the contract. Only the
+// e2ee version is read from the upgrade store, it isn't charged to the tx gas.
+func (app *App) e2eePrecompileEnabled(ctx sdk.Context) bool {
+	key := append([]byte{upgradetypes.VersionMapByte}, e2eetypes.ModuleName...)
+	bz := ctx.MultiStore().GetKVStore(app.keys[upgradetypes.StoreKey]).Get(key)
+	return len(bz) == 8 && binary.BigEndian.Uint64(bz) >= e2eePrecompileModuleVersion
+}
+
 // pruneStaleIBCConsensusStateSubkeys deletes stale keys of the form
 // clients/<id>/consensusStates/<revision>/<height>/clientState left behind when
 // old-format consensus state entries were not cleaned up by the ibc-go v7 migration.
@@ -6,6 +6,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	host "github.com/cosmos/ibc-go/v11/modules/core/24-host"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/v2/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 func TestPruneStaleIBCConsensusStateSubkeys(t *testing.T) {
@@ -88,3 +90,37 @@ func TestUpgradeV18CroBridgeContractAddresses(t *testing.T) {
 	require.ElementsMatch(t, croBridgeContractAddresses, stored.CroBridgeContractAddresses,
 		"CroBridgeContractAddresses not persisted by SetParams")
 }
+
+func TestUpgradeV18EnablesVoteExtensions(t *testing.T) {
+	a := Setup(t, "")
+	ctx := a.NewContext(false).WithBlockHeight(10)
+
+	// the threshold block list is never decrypted without the vote extensions
+	params, err := a.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, params.GetAbci().GetVoteExtensionsEnableHeight())
+
+	require.NoError(t, a.enableVoteExtensions(ctx))
+	params, err = a.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), params.GetAbci().GetVoteExtensionsEnableHeight())
+	require.NotNil(t, params.Block, "the other params are kept")
+
+	// the height enabled already is kept
+	require.NoError(t, a.enableVoteExtensions(ctx.WithBlockHeight(20)))
+	params, err = a.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), params.GetAbci().GetVoteExtensionsEnableHeight())
+}
+
+func TestE2EEPrecompileEnabled(t *testing.T) {
+	a := Setup(t, "")
+	ctx := a.NewContext(false)
+	require.True(t, a.e2eePrecompileEnabled(ctx))
+
+	// the state before the v1.8 upgrade
+	require.NoError(t, a.UpgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{e2eetypes.ModuleName: 1}))
+	require.False(t, a.e2eePrecompileEnabled(ctx))
+	require.NoError(t, a.UpgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{e2eetypes.ModuleName: e2eePrecompileModuleVersion}))
+	require.True(t, a.e2eePrecompileEnabled(ctx))
+}
@@ -6,8 +6,10 @@ import (
 	"os"
 	"path/filepath"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/crypto-org-chain/cronos-store/versiondb"
 	"github.com/crypto-org-chain/cronos-store/versiondb/tsrocksdb"
+	cronosrpc "github.com/crypto-org-chain/cronos/x/cronos/rpc"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 )
@@ -17,6 +19,7 @@ func (app *App) setupVersionDB(
 	keys map[string]*storetypes.KVStoreKey,
 	tkeys map[string]*storetypes.TransientStoreKey,
 	okeys map[string]*storetypes.ObjectStoreKey,
+	changeSetIndex bool,
 ) (storetypes.MultiStore, error) {
 	dataDir := filepath.Join(homePath, "data", "versiondb")
 	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
@@ -28,6 +31,19 @@ func (app *App) setupVersionDB(
 		return nil, err
 	}
 
+	var versionStore versiondb.VersionStore = versionDB
+	if changeSetIndex {
+		// the keys written by each version are indexed for the state diff of the cronos json-rpc namespace.
+		changeSetDB, err := dbm.NewDB("changesets", dbm.RocksDBBackend, dataDir)
+		if err != nil {
+			return nil, err
+		}
+		app.changeSetIndex = cronosrpc.NewChangeSetIndex(changeSetDB)
+		versionStore = indexedVersionStore{VersionStore: versionDB, index: app.changeSetIndex}
+		// serve the historical state queries of the cronos json-rpc namespace.
+		cronosrpc.SetHistoricalStore(versionDB, app.changeSetIndex)
+	}
+
 	// always listen for all keys to simplify configuration
 	exposedKeys := make([]storetypes.StoreKey, 0, len(keys))
 	for _, key := range keys {
@@ -42,7 +58,7 @@ func (app *App) setupVersionDB(
 	// register in app streaming manager
 	sm := app.StreamingManager()
 	sm.ABCIListeners = append(sm.ABCIListeners,
-		versiondb.NewStreamingService(versionDB),
+		versiondb.NewStreamingService(versionStore),
 	)
 	app.SetStreamingManager(sm)
 
@@ -58,3 +74,16 @@ func (app *App) setupVersionDB(
 	app.SetQueryMultiStore(verDB)
 	return verDB, nil
 }
+
+// indexedVersionStore indexes the change sets before writing them to versiondb.
+type indexedVersionStore struct {
+	versiondb.VersionStore
+	index *cronosrpc.ChangeSetIndex
+}
+
+func (s indexedVersionStore) PutAtVersion(version int64, changeSet []*storetypes.StoreKVPair) error {
+	if err := s.index.Record(version, changeSet); err != nil {
+		return err
+	}
+	return s.VersionStore.PutAtVersion(version, changeSet)
+}
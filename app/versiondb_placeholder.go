@@ -13,6 +13,7 @@ func (app *App) setupVersionDB(
 	keys map[string]*storetypes.KVStoreKey,
 	tkeys map[string]*storetypes.TransientStoreKey,
 	okeys map[string]*storetypes.ObjectStoreKey,
+	changeSetIndex bool,
 ) (storetypes.MultiStore, error) {
 	return nil, errors.New("versiondb is not supported in this binary")
 }
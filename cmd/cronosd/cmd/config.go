@@ -3,11 +3,14 @@ package cmd
 type VersionDBConfig struct {
 	// Enable defines if the versiondb should be enabled.
 	Enable bool `mapstructure:"enable"`
+	// ChangeSetIndex defines if the keys written by each version are indexed for cronos_stateDiff.
+	ChangeSetIndex bool `mapstructure:"changeset-index"`
 }
 
 func DefaultVersionDBConfig() VersionDBConfig {
 	return VersionDBConfig{
-		Enable: false,
+		Enable:         false,
+		ChangeSetIndex: false,
 	}
 }
 
@@ -15,4 +18,8 @@ var DefaultVersionDBTemplate = `
 [versiondb]
 # Enable defines if the versiondb should be enabled.
 enable = {{ .VersionDB.Enable }}
+# ChangeSetIndex defines if the keys written by each version to the bank and evm stores are indexed in
+# data/versiondb/changesets, it's required by cronos_stateDiff. It costs one index entry per changed key
+# per block, about the key size plus 8 bytes before compression, and grows without pruning like versiondb.
+changeset-index = {{ .VersionDB.ChangeSetIndex }}
 `
@@ -38,6 +38,25 @@ type CronosConfig struct {
 	// Caches the PendingTxs() pool-scan result, invalidated on tx admission
 	// and block completion. Default true. false always walks the pool.
 	MempoolPendingTxCacheEnabled bool `mapstructure:"mempool-pending-tx-cache-enabled"`
+	// E2EEIdentityReloadInterval is the interval the validator polls the e2ee
+	// keyring for a new identity. 0 disables the polling, the identity is still
+	// reloaded on SIGHUP or by the reload-identity command.
+	E2EEIdentityReloadInterval time.Duration `mapstructure:"e2ee-identity-reload-interval"`
+	// E2EEIdentityGracePeriod is how long the replaced e2ee identity keeps
+	// decrypting the block list after a reload.
+	E2EEIdentityGracePeriod time.Duration `mapstructure:"e2ee-identity-grace-period"`
+	// E2EERemoteKeyring is the address of the e2ee.KeyService, e.g. a KMS, holding
+	// the e2ee identity of the validator instead of the local keyring. Empty uses
+	// the local keyring.
+	E2EERemoteKeyring string `mapstructure:"e2ee-remote-keyring"`
+	// E2EERemoteKeyringTLSCert and E2EERemoteKeyringTLSKey are the client
+	// certificate of the node for the mutual tls with the remote keyring.
+	E2EERemoteKeyringTLSCert string `mapstructure:"e2ee-remote-keyring-tls-cert"`
+	E2EERemoteKeyringTLSKey  string `mapstructure:"e2ee-remote-keyring-tls-key"`
+	// E2EERemoteKeyringTLSCA verifies the remote keyring certificate, the
+	// system roots if empty. The remote keyring needs tls unless it's a unix
+	// socket.
+	E2EERemoteKeyringTLSCA string `mapstructure:"e2ee-remote-keyring-tls-ca"`
 }
 
 const (
@@ -49,6 +68,10 @@ const (
 	// DefaultMempoolTTLNumBlocks evicts mempool.type=app txs older than this many
 	// blocks by arrival height, draining proposal-skipped txs that never commit.
 	DefaultMempoolTTLNumBlocks = 120
+	// DefaultE2EEIdentityReloadInterval is the e2ee keyring polling interval used when E2EEIdentityReloadInterval is unset.
+	DefaultE2EEIdentityReloadInterval = time.Minute
+	// DefaultE2EEIdentityGracePeriod is the grace period used when E2EEIdentityGracePeriod is unset.
+	DefaultE2EEIdentityGracePeriod = 24 * time.Hour
 )
 
 const (
@@ -85,6 +108,8 @@ func DefaultCronosConfig() CronosConfig {
 		MaxTxPerBlock:                DefaultMaxTxPerBlock,
 		MempoolTxTTLEnabled:          true,
 		MempoolPendingTxCacheEnabled: true,
+		E2EEIdentityReloadInterval:   DefaultE2EEIdentityReloadInterval,
+		E2EEIdentityGracePeriod:      DefaultE2EEIdentityGracePeriod,
 	}
 }
 
@@ -38,6 +38,31 @@ mempool-tx-ttl-enabled = {{ .Cronos.MempoolTxTTLEnabled }}
 # Caches the PendingTxs() pool-scan result, invalidated on tx admission and
 # block completion. Default true. false always walks the pool.
 mempool-pending-tx-cache-enabled = {{ .Cronos.MempoolPendingTxCacheEnabled }}
+
+# Interval the validator polls the e2ee keyring for a new identity, so the e2ee
+# key is rotated without a restart. "0s" disables the polling, the identity is
+# still reloaded on SIGHUP or by the reload-identity command. Default "1m0s".
+e2ee-identity-reload-interval = "{{ .Cronos.E2EEIdentityReloadInterval }}"
+
+# How long the replaced e2ee identity keeps decrypting the block list after a
+# reload, until the block list is re-encrypted to the new key. Default "24h0m0s".
+e2ee-identity-grace-period = "{{ .Cronos.E2EEIdentityGracePeriod }}"
+
+# Address of the remote keyring serving the e2ee.KeyService, e.g. a KMS, the
+# validator e2ee identity never leaves it. The unwrapped file keys cross the
+# connection, so the addresses other than a unix socket
+# "unix:///path/to/kms.sock" require the tls options below. Empty uses the
+# local keyring.
+e2ee-remote-keyring = "{{ .Cronos.E2EERemoteKeyring }}"
+
+# PEM client certificate and key of the node for the mutual tls with the
+# remote keyring.
+e2ee-remote-keyring-tls-cert = "{{ .Cronos.E2EERemoteKeyringTLSCert }}"
+e2ee-remote-keyring-tls-key = "{{ .Cronos.E2EERemoteKeyringTLSKey }}"
+
+# PEM bundle verifying the remote keyring certificate, the system roots if
+# empty.
+e2ee-remote-keyring-tls-ca = "{{ .Cronos.E2EERemoteKeyringTLSCA }}"
 `
 
 // DefaultRocksDBConfigTemplate defines the configuration template for rocksdb configuration
@@ -1218,6 +1218,33 @@ class CosmosCLI:
             )
         )
 
+    def query_transaction_receipts(self, height: int):
+        "query the receipts of the eth transactions included in the block"
+        return json.loads(
+            self.raw(
+                "query",
+                "cronos",
+                "transaction-receipts",
+                height,
+                home=self.data_dir,
+                output="json",
+            )
+        )
+
+    def query_replay_block(self, height: int, post_upgrade=False):
+        "replay the eth transactions included in the block"
+        return json.loads(
+            self.raw(
+                "query",
+                "cronos",
+                "replay-block",
+                height,
+                *(["--post-upgrade"] if post_upgrade else []),
+                home=self.data_dir,
+                output="json",
+            )
+        )
+
     def get_default_kwargs(self):
         return {
             "gas_prices": DEFAULT_GAS_PRICE,
@@ -1839,7 +1866,7 @@ class CosmosCLI:
             rsp = self.event_query_tx_for(rsp["txhash"])
         return rsp
 
-    def store_blocklist(self, data, **kwargs):
+    def store_blocklist(self, data, verify_coverage=False, **kwargs):
         kwargs.setdefault("gas_prices", DEFAULT_GAS_PRICE)
         kwargs.setdefault("gas", DEFAULT_GAS)
         rsp = json.loads(
@@ -1848,6 +1875,7 @@ class CosmosCLI:
                 "cronos",
                 "store-block-list",
                 data,
+                *(["--verify-coverage"] if verify_coverage else []),
                 "-y",
                 home=self.data_dir,
                 **kwargs,
@@ -1986,7 +2014,7 @@ class CosmosCLI:
             )
         ).get("send_enabled", [])
 
-    def query_e2ee_key(self, address):
+    def query_e2ee_key(self, address, at_height=None):
         return json.loads(
             self.raw(
                 "q",
@@ -1995,9 +2023,22 @@ class CosmosCLI:
                 address,
                 home=self.data_dir,
                 output="json",
+                at_height=at_height,
             )
         ).get("key")
 
+    def query_e2ee_key_history(self, address):
+        return json.loads(
+            self.raw(
+                "q",
+                "e2ee",
+                "key-history",
+                address,
+                home=self.data_dir,
+                output="json",
+            )
+        ).get("records", [])
+
     def query_e2ee_keys(self, *addresses):
         return json.loads(
             self.raw(
@@ -2028,6 +2069,24 @@ class CosmosCLI:
             rsp = self.event_query_tx_for(rsp["txhash"])
         return rsp
 
+    def revoke_e2ee_key(self, key, **kwargs):
+        kwargs.setdefault("gas_prices", DEFAULT_GAS_PRICE)
+        kwargs.setdefault("gas", DEFAULT_GAS)
+        rsp = json.loads(
+            self.raw(
+                "tx",
+                "e2ee",
+                "revoke-encryption-key",
+                key,
+                "-y",
+                home=self.data_dir,
+                **kwargs,
+            )
+        )
+        if rsp["code"] == 0:
+            rsp = self.event_query_tx_for(rsp["txhash"])
+        return rsp
+
     def e2ee_keygen(self, **kwargs):
         return self.raw("e2ee", "keygen", home=self.data_dir, **kwargs).strip().decode()
 
@@ -2062,12 +2121,13 @@ class CosmosCLI:
             .decode()
         )
 
-    def e2ee_encrypt_to_validators(self, input, **kwargs):
+    def e2ee_encrypt_to_validators(self, input, allow_uncovered=False, **kwargs):
         return (
             self.raw(
                 "e2ee",
                 "encrypt-to-validators",
                 input,
+                *(["--allow-uncovered"] if allow_uncovered else []),
                 home=self.data_dir,
                 **kwargs,
             )
@@ -2075,5 +2135,56 @@ class CosmosCLI:
             .decode()
         )
 
+    def e2ee_validators_coverage(self):
+        return json.loads(
+            self.raw(
+                "e2ee",
+                "validators-coverage",
+                home=self.data_dir,
+                output="json",
+            )
+        )
+
+    def e2ee_reload_identity(self):
+        return json.loads(
+            self.raw(
+                "e2ee",
+                "reload-identity",
+                home=self.data_dir,
+                output="json",
+            )
+        )
+
+    def e2ee_send_encrypted(self, input, *recipients, **kwargs):
+        kwargs.setdefault("gas_prices", DEFAULT_GAS_PRICE)
+        kwargs.setdefault("gas", DEFAULT_GAS)
+        rsp = json.loads(
+            self.raw(
+                "tx",
+                "e2ee",
+                "send-encrypted",
+                input,
+                *itertools.chain.from_iterable(("-r", r) for r in recipients),
+                "-y",
+                home=self.data_dir,
+                **kwargs,
+            )
+        )
+        if rsp["code"] == 0:
+            rsp = self.event_query_tx_for(rsp["txhash"])
+        return rsp
+
+    def e2ee_inbox(self, address, identity="e2ee-identity", **kwargs):
+        return json.loads(
+            self.raw(
+                "e2ee",
+                "inbox",
+                address,
+                home=self.data_dir,
+                identity=identity,
+                **kwargs,
+            )
+        )
+
     def prune(self, kind="everything"):
         return self.raw("prune", kind, home=self.data_dir).decode()
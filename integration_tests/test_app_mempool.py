@@ -23,7 +23,14 @@ import web3
 from web3 import Web3
 
 from .network import setup_custom_cronos
-from .utils import ADDRS, CONTRACTS, KEYS, deploy_contract, sign_transaction
+from .utils import (
+    ADDRS,
+    CONTRACTS,
+    KEYS,
+    deploy_contract,
+    sign_transaction,
+    wait_for_new_blocks,
+)
 
 pytestmark = pytest.mark.slow
 
@@ -481,3 +488,59 @@ def test_txpool_pending_tx_visible(cronos_app_mempool):
     assert (
         nonce_key in cf["pending"]
     ), f"contentFrom missing nonce {nonce}: {cf['pending']}"
+
+
+def test_suggest_fees(cronos_app_mempool):
+    """cronos_suggestFees returns ordered fee tiers covering the baseFee."""
+    w3 = cronos_app_mempool.w3
+    rsp = w3.provider.make_request("cronos_suggestFees", [])
+    assert "error" not in rsp, rsp
+    result = rsp["result"]
+    base_fee = int(result["baseFee"], 16)
+    latest = w3.eth.block_number
+    tiers = [result[name] for name in ("low", "medium", "high")]
+    for tier in tiers:
+        max_fee = int(tier["maxFeePerGas"], 16)
+        tip = int(tier["maxPriorityFeePerGas"], 16)
+        assert max_fee > base_fee
+        assert max_fee >= base_fee + tip
+        assert int(tier["estimatedInclusionBlock"], 16) > latest - 1
+    tips = [int(tier["maxPriorityFeePerGas"], 16) for tier in tiers]
+    assert tips == sorted(tips)
+
+
+def test_account_status_nonce_gap(cronos_app_mempool):
+    """cronos_accountStatus reports the txs queued behind a nonce gap."""
+    w3 = cronos_app_mempool.w3
+    sender = ADDRS["signer1"]
+    nonce = w3.eth.get_transaction_count(sender)
+    tx = {
+        "to": ADDRS["community"],
+        "value": 1,
+        "nonce": nonce + 1,
+        "gas": 21000,
+        "gasPrice": w3.eth.gas_price,
+    }
+    w3.eth.send_raw_transaction(
+        sign_transaction(w3, tx, KEYS["signer1"]).raw_transaction
+    )
+
+    rsp = w3.provider.make_request("cronos_accountStatus", [sender])
+    assert "error" not in rsp, rsp
+    status = rsp["result"]
+    assert int(status["committedNonce"], 16) == nonce
+    assert int(status["highestPooledNonce"], 16) == nonce + 1
+    assert [int(n, 16) for n in status["gaps"]] == [nonce]
+    assert [int(n, 16) for n in status["queuedBehindGap"]] == [nonce + 1]
+    assert status["blocklisted"] in (None, False)
+
+    # fill the gap, both txs are included
+    tx["nonce"] = nonce
+    txhash = w3.eth.send_raw_transaction(
+        sign_transaction(w3, tx, KEYS["signer1"]).raw_transaction
+    )
+    w3.eth.wait_for_transaction_receipt(txhash, timeout=30)
+    wait_for_new_blocks(cronos_app_mempool.cosmos_cli(), 2)
+    status = w3.provider.make_request("cronos_accountStatus", [sender])["result"]
+    assert int(status["committedNonce"], 16) == nonce + 2
+    assert status["gaps"] == [] and status["queuedBehindGap"] == []
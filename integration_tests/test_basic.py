@@ -28,6 +28,7 @@ from .utils import (
     derive_new_account,
     fund_acc,
     get_expedited_params,
+    get_logs_by_block_range,
     get_receipts_by_block,
     get_sync_info,
     mempool_type,
@@ -148,6 +149,20 @@ def test_events(cluster, suspend_capture):
     for topic in expect_log["topics"]:
         assert topic in bloom
 
+    # check the cronos_getLogsByBlockRange api
+    blk = hex(txreceipt.blockNumber)
+    topic = Web3.to_hex(expect_log["topics"][0])
+    rsp = get_logs_by_block_range(
+        w3, blk, blk, {"address": [erc20.address], "topics": [[topic]]}
+    )
+    assert "error" not in rsp, rsp["error"]
+    assert rsp["result"]["cursor"] is None
+    logs = rsp["result"]["logs"]
+    assert len(logs) == 1
+    assert logs[0]["transactionHash"] == Web3.to_hex(txreceipt.transactionHash)
+    rsp = get_logs_by_block_range(w3, blk, blk, {"address": [ADDRS["community"]]})
+    assert rsp["result"]["logs"] == []
+
 
 def test_minimal_gas_price(cronos):
     w3 = cronos.w3
@@ -798,6 +813,16 @@ def test_batch_tx(cronos):
     # check nonce
     assert w3.eth.get_transaction_count(sender) == nonce + 3
 
+    # check the replay query of the cronos module
+    res = cli.query_replay_block(receipts[0].blockNumber)
+    assert [b["transaction_hash"] for b in res["receipts"]] == [
+        Web3.to_hex(h) for h in tx_hashes
+    ]
+    for a, b in zip(receipts, res["receipts"]):
+        assert int(b["status"]) == a.status
+        assert int(b["gas_used"]) == a.gasUsed
+        assert b["contract_address"] == (a.contractAddress or "")
+
     # check traceTransaction
     rsps = [
         w3.provider.make_request("debug_traceTransaction", [Web3.to_hex(h)])["result"]
@@ -864,6 +889,30 @@ def test_failed_transfer_tx(cronos):
     for a, b in zip(receipts, rsp["result"]):
         assert a == b
 
+    # test the receipts query of the cronos module
+    res = cli.query_transaction_receipts(receipts[0].blockNumber)
+    assert int(res["height"]) == receipts[0].blockNumber
+    assert len(receipts) == len(res["receipts"])
+    for a, b in zip(receipts, res["receipts"]):
+        assert b["transaction_hash"] == Web3.to_hex(a.transactionHash)
+        assert int(b["status"]) == a.status
+        assert int(b["gas_used"]) == a.gasUsed
+        assert int(b["cumulative_gas_used"]) == a.cumulativeGasUsed
+
+    # test the cronos_getBlockReceiptsWithCosmosEvents api
+    rsp = w3.provider.make_request(
+        "cronos_getBlockReceiptsWithCosmosEvents", [hex(receipts[0].blockNumber)]
+    )
+    assert "error" not in rsp, rsp["error"]
+    assert len(receipts) == len(rsp["result"])
+    for a, b in zip(receipts, rsp["result"]):
+        assert b["txType"] == "ethereum"
+        assert b["transactionHash"] == Web3.to_hex(a.transactionHash)
+        assert int(b["gasUsed"], 16) == a.gasUsed
+        assert all(
+            ev["type"] not in ("ethereum_tx", "tx_log") for ev in b["cosmosEvents"]
+        )
+
     # check traceTransaction
     rsps = [
         w3.provider.make_request("debug_traceTransaction", [Web3.to_hex(h)])
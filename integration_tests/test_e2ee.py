@@ -305,3 +305,117 @@ def test_block_list_unblockable(cronos):
     encrypt_to_validators(cli, {})
     wait_for_new_blocks(cli, 2)
     assert get_account_nonce(w3, KEYS["community"]) == blocked_nonce + 1
+
+
+def test_key_rotation(cronos: Cronos):
+    cli = cronos.cosmos_cli()
+    addr = cli.address("community")
+    pubkey0 = cli.e2ee_keygen(keyring_name="rotation0")
+    pubkey1 = cli.e2ee_keygen(keyring_name="rotation1")
+
+    rsp = cli.register_e2ee_key(pubkey0, _from="community")
+    assert rsp["code"] == 0, rsp["raw_log"]
+    height0 = int(rsp["height"])
+    rsp = cli.register_e2ee_key(pubkey1, _from="community")
+    assert rsp["code"] == 0, rsp["raw_log"]
+    height1 = int(rsp["height"])
+
+    # the previous key is still available at the old height
+    assert cli.query_e2ee_key(addr) == pubkey1
+    assert cli.query_e2ee_key(addr, at_height=height1 - 1) == pubkey0
+    assert [r["key"] for r in cli.query_e2ee_key_history(addr)] == [
+        pubkey0,
+        pubkey1,
+    ]
+
+    rsp = cli.revoke_e2ee_key(pubkey1, _from="community")
+    assert rsp["code"] == 0, rsp["raw_log"]
+    assert not cli.query_e2ee_key(addr)
+    assert cli.query_e2ee_key(addr, at_height=height0) == pubkey0
+    records = cli.query_e2ee_key_history(addr)
+    assert int(records[1]["revoked_height"]) == int(rsp["height"])
+
+
+def test_validators_coverage(cronos: Cronos):
+    gen_validator_identity(cronos)
+    cli = cronos.cosmos_cli()
+    coverage = cli.e2ee_validators_coverage()
+    assert not coverage.get("uncovered")
+    assert len(coverage["covered"]) == len(cronos.config["validators"])
+
+    plainfile = cli.data_dir / "plaintext"
+    plainfile.write_text(json.dumps({}))
+    cipherfile = cli.data_dir / "ciphertext"
+
+    # the blob missing the stanzas of some validators is rejected
+    cli.e2ee_encrypt(plainfile, cli.address("validator"), output=cipherfile)
+    rsp = cli.store_blocklist(cipherfile, verify_coverage=True, _from="validator")
+    assert rsp["code"] != 0
+    assert "doesn't cover the bonded validators" in rsp["raw_log"]
+
+    cli.e2ee_encrypt_to_validators(plainfile, output=cipherfile)
+    rsp = cli.store_blocklist(cipherfile, verify_coverage=True, _from="validator")
+    assert rsp["code"] == 0, rsp["raw_log"]
+
+
+def test_hybrid_key(cronos: Cronos):
+    cli = cronos.cosmos_cli()
+    pubkey = cli.e2ee_keygen(keyring_name="hybrid", key_type="hybrid")
+    assert pubkey.startswith("age1pq1")
+    assert cli.e2ee_pubkey(keyring_name="hybrid") == pubkey
+    rsp = cli.register_e2ee_key(pubkey, _from="signer1")
+    assert rsp["code"] == 0, rsp["raw_log"]
+    assert cli.query_e2ee_key(cli.address("signer1")) == pubkey
+
+    content = "Hello Post-Quantum!"
+    plainfile = cli.data_dir / "plaintext"
+    plainfile.write_text(content)
+    cipherfile = cli.data_dir / "ciphertext"
+    cli.e2ee_encrypt(plainfile, cli.address("signer1"), output=cipherfile)
+    assert cli.e2ee_decrypt(cipherfile, identity="hybrid") == content
+
+
+def test_inbox(cronos: Cronos):
+    gen_validator_identity(cronos)
+    cli = cronos.cosmos_cli()
+    validator = cli.address("validator")
+    content = "Hello Inbox!"
+    plainfile = cli.data_dir / "plaintext"
+    plainfile.write_text(content)
+
+    rsp = cli.e2ee_send_encrypted(plainfile, validator, _from="signer1")
+    assert rsp["code"] == 0, rsp["raw_log"]
+
+    msgs = cli.e2ee_inbox(validator)["messages"]
+    assert msgs[-1]["sender"] == cli.address("signer1")
+    assert msgs[-1]["message"] == content
+
+
+def test_reload_identity(cronos: Cronos):
+    gen_validator_identity(cronos)
+    for i in range(len(cronos.config["validators"])):
+        cli = cronos.cosmos_cli(i)
+        pubkey = cli.e2ee_keygen()
+        rsp = cli.register_e2ee_key(pubkey, _from="validator")
+        assert rsp["code"] == 0, rsp["raw_log"]
+        # the node picks up the new identity without a restart, unless the
+        # keyring polling did it already
+        cli.e2ee_reload_identity()
+        assert not cli.e2ee_reload_identity().get("rotated")
+
+    # the block list encrypted to the new keys is decrypted
+    cli = cronos.cosmos_cli()
+    user = cli.address("signer2")
+    encrypt_to_validators(cli, {"addresses": [user]})
+    rsp = cli.transfer(
+        user, cli.address("validator"), "1basetcro", event_query_tx=False
+    )
+    assert rsp["code"] == 0, rsp["raw_log"]
+    with pytest.raises(AssertionError) as exc:
+        cli.event_query_tx_for(rsp["txhash"])
+    assert "timed out waiting" in str(exc.value)
+    nonce = get_nonce(cli, user)
+
+    encrypt_to_validators(cli, {})
+    wait_for_new_blocks(cli, 1)
+    assert nonce + 1 == get_nonce(cli, user)
@@ -139,3 +139,22 @@ def test_block_overflow(custom_cronos):
     replay_receipts = [AttributeDict(receipt_formatter(item)) for item in rsp["result"]]
     assert replay_receipts[1].status == 0
     assert replay_receipts[1].gasUsed == gas_limits[replay_receipts[1]["from"]]
+
+    # check the traces of the replayed block
+    rsp = w3.provider.make_request(
+        "cronos_traceReplayBlock",
+        [hex(success.blockNumber), {"tracer": "callTracer", "postUpgrade": False}],
+    )
+    assert "error" not in rsp, rsp["error"]
+    assert 2 == len(rsp["result"])
+    for item in rsp["result"]:
+        assert item["result"]["to"].lower() == contract.address.lower()
+
+    rsp = w3.provider.make_request(
+        "cronos_traceReplayBlock",
+        [hex(success.blockNumber), {"tracer": "callTracer", "postUpgrade": True}],
+    )
+    assert "error" not in rsp, rsp["error"]
+    assert 2 == len(rsp["result"])
+    assert "result" in rsp["result"][0]
+    assert "error" in rsp["result"][1]
@@ -42,7 +42,7 @@ class Client:
                 await self._rsps[msg["id"]].put(msg)
             else:
                 # subscriptions
-                assert msg["method"] == "eth_subscription"
+                assert msg["method"] in ("eth_subscription", "cronos_subscription")
                 sub_id = msg["params"]["subscription"]
                 await self._subs[sub_id].put(msg["params"]["result"])
 
@@ -54,10 +54,12 @@ class Client:
     async def recv_subscription(self, sub_id):
         return await self._subs[sub_id].get()
 
-    async def subscribe(self, *args):
+    async def subscribe(self, *args, namespace="eth"):
         rpcid = self.gen_id()
         await self._ws.send(
-            json.dumps({"id": rpcid, "method": "eth_subscribe", "params": args})
+            json.dumps(
+                {"id": rpcid, "method": f"{namespace}_subscribe", "params": args}
+            )
         )
         rsp = await self.recv_response(rpcid)
         assert "error" not in rsp
@@ -66,10 +68,16 @@ class Client:
     def sub_qsize(self, sub_id):
         return self._subs[sub_id].qsize()
 
-    async def unsubscribe(self, sub_id):
+    async def unsubscribe(self, sub_id, namespace="eth"):
         rpcid = self.gen_id()
         await self._ws.send(
-            json.dumps({"id": rpcid, "method": "eth_unsubscribe", "params": [sub_id]})
+            json.dumps(
+                {
+                    "id": rpcid,
+                    "method": f"{namespace}_unsubscribe",
+                    "params": [sub_id],
+                }
+            )
         )
         rsp = await self.recv_response(rpcid)
         assert "error" not in rsp
@@ -167,3 +175,44 @@ def test_subscribe_basic(cronos: Cronos):
 
     timeout = 100
     loop.run_until_complete(asyncio.wait_for(async_test(), timeout))
+
+
+def test_subscribe_token_mapping(cronos: Cronos):
+    """
+    test the cronos_subscribe feed of the token mapping changes
+    """
+    wait_for_port(ports.evmrpc_ws_port(cronos.base_port(0)))
+    cli = cronos.cosmos_cli()
+    loop = asyncio.get_event_loop()
+    crc21 = deploy_contract(cronos.w3, CONTRACTS["TestERC20Utility"])
+    denom = f"gravity{ADDRS['community']}"
+
+    async def async_test():
+        async with websockets.connect(cronos.w3_ws_endpoint()) as ws:
+            c = Client(ws)
+            t = asyncio.create_task(c.receive_loop())
+            sub_id = await c.subscribe("tokenMappingUpdates", namespace="cronos")
+            rsp = await loop.run_in_executor(
+                None,
+                lambda: cli.update_token_mapping(
+                    denom, crc21.address, "", 0, from_="validator"
+                ),
+            )
+            assert rsp["code"] == 0, rsp["raw_log"]
+            msg = await c.recv_subscription(sub_id)
+            assert msg["cosmosTxHash"] == rsp["txhash"]
+            attrs = {
+                attr["key"]: attr["value"] for attr in msg["event"]["attributes"]
+            }
+            assert msg["event"]["type"] == "token_mapping_updated"
+            assert attrs["denom"] == denom
+            assert attrs["contract"] == crc21.address
+            assert attrs["auto_contract"] == "false"
+            assert await c.unsubscribe(sub_id, namespace="cronos")
+            t.cancel()
+            try:
+                await t
+            except asyncio.CancelledError:
+                pass
+
+    loop.run_until_complete(asyncio.wait_for(async_test(), 60))
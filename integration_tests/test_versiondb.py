@@ -14,6 +14,40 @@ from .utils import (
 )
 
 
+def test_state_diff(cronos: Cronos):
+    "cronos_stateDiff reads the account changes from versiondb"
+    w3 = cronos.w3
+    sender = ADDRS["validator"]
+    recipient = ADDRS["community"]
+    block0 = w3.eth.block_number
+    nonce0 = w3.eth.get_transaction_count(sender)
+    receipt = send_transaction(w3, {"from": sender, "to": recipient, "value": 1000})
+    w3_wait_for_block(w3, receipt.blockNumber + 1)
+
+    rsp = w3.provider.make_request(
+        "cronos_stateDiff", [recipient, hex(block0), hex(receipt.blockNumber)]
+    )
+    assert "error" not in rsp, rsp["error"]
+    diff = rsp["result"]
+    assert diff["nonce"] is None
+    balance = next(b for b in diff["balances"] if b["denom"] == "basetcro")
+    assert int(balance["to"], 16) - int(balance["from"], 16) == 1000
+
+    rsp = w3.provider.make_request(
+        "cronos_stateDiff", [sender, hex(block0), hex(receipt.blockNumber)]
+    )
+    assert "error" not in rsp, rsp["error"]
+    assert rsp["result"]["nonce"] == {"from": hex(nonce0), "to": hex(nonce0 + 1)}
+
+    # no change in the same block
+    rsp = w3.provider.make_request(
+        "cronos_stateDiff", [sender, hex(block0), hex(block0)]
+    )
+    assert "error" not in rsp, rsp["error"]
+    assert rsp["result"]["nonce"] is None
+    assert rsp["result"]["balances"] == []
+
+
 def test_versiondb_migration(cronos: Cronos):
     """
     test versiondb migration commands.
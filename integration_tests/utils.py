@@ -673,6 +673,12 @@ def get_receipts_by_block(w3, blk):
     return rsp
 
 
+def get_logs_by_block_range(w3, start, end, filter, cursor=None):
+    return w3.provider.make_request(
+        "cronos_getLogsByBlockRange", [start, end, filter, cursor]
+    )
+
+
 def send_raw_transactions(w3, raw_transactions):
     with ThreadPoolExecutor(len(raw_transactions)) as exec:
         tasks = [
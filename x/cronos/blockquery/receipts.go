@@ -0,0 +1,213 @@
+package blockquery
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExceedBlockGasLimitError is the log of the tx that exceeded the block gas limit.
+const ExceedBlockGasLimitError = "out of gas in location: block gas meter; gasWanted:"
+
+// Block is a committed block with its results and the evm parameters to build the receipts, it's shared by the
+// gRPC block queries and the cronos json-rpc apis.
+type Block struct {
+	Block   *cmttypes.Block
+	Results *coretypes.ResultBlockResults
+	Hash    string
+	BaseFee *big.Int
+	Signer  ethtypes.Signer
+}
+
+// Receipt is the receipt of an eth message included in the block, rendered by each api in its own format.
+type Receipt struct {
+	Tx                *ethtypes.Transaction
+	From              common.Address
+	TxIndex           uint64
+	Failed            bool
+	GasUsed           uint64
+	CumulativeGasUsed uint64
+	Logs              []*ethtypes.Log
+	// ContractAddress is nil unless the tx creates a contract.
+	ContractAddress *common.Address
+	// EffectiveGasPrice is nil unless it's a dynamic fee tx.
+	EffectiveGasPrice *big.Int
+}
+
+// newReceipt builds the receipt of the eth message included in the block.
+func newReceipt(
+	block *Block,
+	ethMsg *evmtypes.MsgEthereumTx,
+	from common.Address,
+	txIndex uint64,
+	failed bool,
+	gasUsed, cumulativeGasUsed uint64,
+	logs []*ethtypes.Log,
+) Receipt {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	txData := ethMsg.AsTransaction()
+	receipt := Receipt{
+		Tx:                txData,
+		From:              from,
+		TxIndex:           txIndex,
+		Failed:            failed,
+		GasUsed:           gasUsed,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              logs,
+	}
+	if txData.To() == nil {
+		contract := crypto.CreateAddress(from, txData.Nonce())
+		receipt.ContractAddress = &contract
+	}
+	if txData.Type() == ethtypes.DynamicFeeTxType && block.BaseFee != nil {
+		receipt.EffectiveGasPrice = ethMsg.GetEffectiveGasPrice(block.BaseFee)
+	}
+	return receipt
+}
+
+// revertExceeded patches the receipt of the tx that exceeded the block gas limit as reverted, the fee is
+// deducted by the gas limit, so the gas used is patched to the gas limit.
+func (r *Receipt) revertExceeded(gasLimit uint64) {
+	refundedGas := gasLimit - r.GasUsed
+	r.Failed = true
+	r.Logs = []*ethtypes.Log{}
+	r.ContractAddress = nil
+	r.GasUsed = gasLimit
+	r.CumulativeGasUsed += refundedGas
+}
+
+// BuildEthReceipts builds the receipts of the eth transactions included in the block from the block results.
+func BuildEthReceipts(txDecoder sdk.TxDecoder, block *Block) ([]Receipt, error) {
+	receipts := []Receipt{}
+	txIndex := uint64(0)
+	cumulativeGasUsed := uint64(0)
+	for i, txBz := range block.Block.Txs {
+		txResult := block.Results.TxsResults[i]
+
+		// don't ignore the txs which exceed block gas limit.
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			continue
+		}
+
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode tx: %w", err)
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: %d:%d, %w", block.Block.Height, i, err)
+		}
+
+		if len(parsedTxs.Txs) == 0 {
+			// not an evm tx
+			cumulativeGasUsed += uint64(txResult.GasUsed)
+			continue
+		}
+
+		if len(parsedTxs.Txs) != len(tx.GetMsgs()) {
+			return nil, fmt.Errorf("wrong number of tx events: %d", txIndex)
+		}
+
+		msgCumulativeGasUsed := uint64(0)
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				return nil, fmt.Errorf("invalid tx type: %T", msg)
+			}
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			from, err := ethMsg.GetSenderLegacy(block.Signer)
+			if err != nil {
+				return nil, err
+			}
+
+			// the logs are left empty if they can't be decoded.
+			logs, _ := evmtypes.DecodeMsgLogsFromEvents(txResult.Data, txResult.Events, parsedTx.MsgIndex, uint64(block.Results.Height))
+			// msgCumulativeGasUsed includes gas used by the current tx
+			msgCumulativeGasUsed += parsedTx.GasUsed
+			receipts = append(receipts, newReceipt(
+				block, ethMsg, from, txIndex, txResult.Code != 0 || parsedTx.Failed,
+				parsedTx.GasUsed, cumulativeGasUsed+msgCumulativeGasUsed, logs,
+			))
+			txIndex++
+		}
+		cumulativeGasUsed += msgCumulativeGasUsed
+	}
+	return receipts, nil
+}
+
+// ReplayMsgs collects the eth messages to replay in the block, and reports whether the last one exceeded the
+// block gas limit.
+func ReplayMsgs(txDecoder sdk.TxDecoder, block *Block) ([]*evmtypes.MsgEthereumTx, bool, error) {
+	blockGasLimitExceeded := false
+	var msgs []*evmtypes.MsgEthereumTx
+	for i, txBz := range block.Block.Txs {
+		txResult := block.Results.TxsResults[i]
+		if txResult.Code != 0 {
+			if !strings.Contains(txResult.Log, ExceedBlockGasLimitError) {
+				continue
+			}
+			// the tx that exceeded the block gas limit should not be ignored because:
+			// 1) before the 0.7.0 upgrade, the tx is committed successfully.
+			// 2) after the upgrade, the tx is failed but fee deducted and nonce increased.
+			// there's at most one such tx in each block, and it should be the last one.
+			blockGasLimitExceeded = true
+		}
+
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to decode tx: %w", err)
+		}
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				msgs = append(msgs, ethMsg)
+			}
+		}
+	}
+	return msgs, blockGasLimitExceeded, nil
+}
+
+// ReplayReceipts builds the receipts of the replayed eth messages, if revertExceeded is true, the last message
+// exceeded the block gas limit and is treated as reverted, like after the 0.7.0 upgrade.
+func ReplayReceipts(
+	block *Block,
+	msgs []*evmtypes.MsgEthereumTx,
+	responses []*evmtypes.MsgEthereumTxResponse,
+	revertExceeded bool,
+) ([]Receipt, error) {
+	if len(responses) != len(msgs) {
+		return nil, fmt.Errorf("wrong number of replay responses: %d, expected %d", len(responses), len(msgs))
+	}
+	receipts := make([]Receipt, 0, len(msgs))
+	var cumulativeGasUsed uint64
+	for i, txResponse := range responses {
+		from, err := msgs[i].GetSenderLegacy(block.Signer)
+		if err != nil {
+			return nil, err
+		}
+		// cumulativeGasUsed includes gas used by the current tx
+		cumulativeGasUsed += txResponse.GasUsed
+		receipts = append(receipts, newReceipt(
+			block, msgs[i], from, uint64(i), txResponse.Failed(), txResponse.GasUsed, cumulativeGasUsed,
+			evmtypes.LogsToEthereum(txResponse.Logs),
+		))
+	}
+
+	if revertExceeded && len(receipts) > 0 {
+		idx := len(receipts) - 1
+		receipts[idx].revertExceeded(msgs[idx].GetGas())
+	}
+	return receipts, nil
+}
@@ -0,0 +1,186 @@
+package blockquery
+
+import (
+	"context"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryContextFn creates the query context at the height, e.g. BaseApp.CreateQueryContext.
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
+var _ types.BlockQuerier = Server{}
+
+// Server implements the block queries of the cronos Query service with the blocks and block results of the node.
+type Server struct {
+	clientCtx    client.Context
+	queryContext QueryContextFn
+	// to replay the eth messages
+	queryServer types.QueryServer
+	evmKeeper   types.EvmKeeper
+}
+
+// NewServer creates the block query server.
+func NewServer(clientCtx client.Context, queryContext QueryContextFn, queryServer types.QueryServer, evmKeeper types.EvmKeeper) Server {
+	return Server{
+		clientCtx:    clientCtx,
+		queryContext: queryContext,
+		queryServer:  queryServer,
+		evmKeeper:    evmKeeper,
+	}
+}
+
+// TransactionReceiptsByBlock implements the Query/TransactionReceiptsByBlock gRPC method
+func (s Server) TransactionReceiptsByBlock(goCtx context.Context, req *types.QueryTransactionReceiptsByBlockRequest) (*types.QueryTransactionReceiptsByBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	detail, err := s.getBlockDetail(goCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := BuildEthReceipts(s.clientCtx.TxConfig.TxDecoder(), detail)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTransactionReceiptsByBlockResponse{
+		Height:    detail.Block.Height,
+		BlockHash: detail.Hash,
+		Receipts:  newEthReceipts(detail, receipts),
+	}, nil
+}
+
+// ReplayBlockByHeight implements the Query/ReplayBlockByHeight gRPC method, the eth messages are replayed on
+// top of the state of the previous block.
+func (s Server) ReplayBlockByHeight(goCtx context.Context, req *types.QueryReplayBlockByHeightRequest) (*types.QueryReplayBlockByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	detail, err := s.getBlockDetail(goCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryReplayBlockByHeightResponse{
+		Height:    detail.Block.Height,
+		BlockHash: detail.Hash,
+		Receipts:  []types.EthReceipt{},
+	}
+
+	msgs, blockGasLimitExceeded, err := ReplayMsgs(s.clientCtx.TxConfig.TxDecoder(), detail)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(msgs) == 0 {
+		return res, nil
+	}
+
+	// the context of the block beginning, 0 is a special value of the query height.
+	ctx, err := s.queryContext(max(detail.Block.Height-1, 1), false)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	rsp, err := s.queryServer.ReplayBlock(ctx, &types.ReplayBlockRequest{
+		Msgs:        msgs,
+		BlockNumber: detail.Block.Height,
+		BlockTime:   detail.Block.Time,
+		BlockHash:   detail.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// after the 0.7.0 upgrade, the tx is always reverted.
+	receipts, err := ReplayReceipts(detail, msgs, rsp.Responses, blockGasLimitExceeded && req.PostUpgrade)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Receipts = newEthReceipts(detail, receipts)
+	return res, nil
+}
+
+// getBlockDetail loads the block at the height with its results, zero height means the latest block.
+func (s Server) getBlockDetail(ctx context.Context, height int64) (*Block, error) {
+	if height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %d", height)
+	}
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	var heightPtr *int64
+	if height > 0 {
+		heightPtr = &height
+	}
+	resBlock, err := node.Block(ctx, heightPtr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, status.Errorf(codes.NotFound, "block not found: %d", height)
+	}
+	height = resBlock.Block.Height
+	results, err := node.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if len(results.TxsResults) != len(resBlock.Block.Txs) {
+		return nil, status.Errorf(codes.Internal, "block results inconsistent with the block: %d", height)
+	}
+
+	// the evm parameters at the end of the block, the same as the ones used in the block.
+	sdkCtx, err := s.queryContext(height, false)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	chainID := s.evmKeeper.ChainID()
+	params := s.evmKeeper.GetParams(sdkCtx)
+	return &Block{
+		Block:   resBlock.Block,
+		Results: results,
+		Hash:    common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		BaseFee: s.evmKeeper.GetBaseFee(sdkCtx, params.ChainConfig.EthereumConfig(chainID)),
+		Signer:  ethtypes.LatestSignerForChainID(chainID),
+	}, nil
+}
+
+// newEthReceipts renders the receipts of the block in the format of the gRPC queries.
+func newEthReceipts(block *Block, receipts []Receipt) []types.EthReceipt {
+	result := make([]types.EthReceipt, 0, len(receipts))
+	for _, r := range receipts {
+		receipt := types.EthReceipt{
+			TransactionHash:   r.Tx.Hash().Hex(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: r.CumulativeGasUsed,
+			GasUsed:           r.GasUsed,
+			LogsBloom:         ethtypes.CreateBloom(&ethtypes.Receipt{Logs: r.Logs}).Bytes(),
+			Logs:              evmtypes.NewLogsFromEth(r.Logs),
+			BlockHash:         block.Hash,
+			BlockNumber:       block.Block.Height,
+			TransactionIndex:  r.TxIndex,
+			From:              r.From.Hex(),
+			Type:              uint32(r.Tx.Type()),
+		}
+		if r.Failed {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		if r.Tx.To() != nil {
+			receipt.To = r.Tx.To().Hex()
+		}
+		if r.ContractAddress != nil {
+			receipt.ContractAddress = r.ContractAddress.Hex()
+		}
+		if r.EffectiveGasPrice != nil {
+			receipt.EffectiveGasPrice = r.EffectiveGasPrice.String()
+		}
+		result = append(result, receipt)
+	}
+	return result
+}
@@ -0,0 +1,227 @@
+package blockquery
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	evmenc "github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRevertExceeded(t *testing.T) {
+	contract := common.BigToAddress(common.Big2)
+	receipt := Receipt{
+		CumulativeGasUsed: 150000,
+		GasUsed:           50000,
+		Logs:              []*ethtypes.Log{{Address: common.BigToAddress(common.Big1)}},
+		ContractAddress:   &contract,
+	}
+
+	receipt.revertExceeded(80000)
+	require.True(t, receipt.Failed)
+	require.Empty(t, receipt.Logs)
+	require.Nil(t, receipt.ContractAddress)
+	require.Equal(t, uint64(80000), receipt.GasUsed)
+	require.Equal(t, uint64(180000), receipt.CumulativeGasUsed)
+}
+
+// fakeNode serves the blocks and the block results in memory, the missing ones are reported as pruned.
+type fakeNode struct {
+	client.CometRPC
+	latest  int64
+	blocks  map[int64]*cmttypes.Block
+	results map[int64]*coretypes.ResultBlockResults
+}
+
+func (n fakeNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	h := n.latest
+	if height != nil {
+		h = *height
+	}
+	block, ok := n.blocks[h]
+	if !ok {
+		return nil, fmt.Errorf("height %d is not available", h)
+	}
+	return &coretypes.ResultBlock{Block: block}, nil
+}
+
+func (n fakeNode) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	res, ok := n.results[*height]
+	if !ok {
+		return nil, fmt.Errorf("block results of height %d are pruned", *height)
+	}
+	return res, nil
+}
+
+type fakeEvmKeeper struct {
+	types.EvmKeeper
+}
+
+func (fakeEvmKeeper) ChainID() *big.Int {
+	return big.NewInt(777)
+}
+
+func (fakeEvmKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return evmtypes.DefaultParams()
+}
+
+func (fakeEvmKeeper) GetBaseFee(sdk.Context, *params.ChainConfig) *big.Int {
+	return nil
+}
+
+// fakeQueryServer replays each message with 21000 gas used.
+type fakeQueryServer struct {
+	types.QueryServer
+	requests *[]*types.ReplayBlockRequest
+}
+
+func (s fakeQueryServer) ReplayBlock(_ context.Context, req *types.ReplayBlockRequest) (*types.ReplayBlockResponse, error) {
+	*s.requests = append(*s.requests, req)
+	rsp := &types.ReplayBlockResponse{}
+	for range req.Msgs {
+		rsp.Responses = append(rsp.Responses, &evmtypes.MsgEthereumTxResponse{GasUsed: 21000})
+	}
+	return rsp, nil
+}
+
+type blockQueryFixture struct {
+	server   Server
+	node     fakeNode
+	sender   common.Address
+	requests []*types.ReplayBlockRequest
+}
+
+// newBlockQueryFixture creates the blocks 2 to 5, the block 2 is empty, the blocks 3 and 4 have a transfer, the one
+// of block 4 exceeded the block gas limit. The results of block 5 and the state of block 2 are pruned.
+func newBlockQueryFixture(t *testing.T) *blockQueryFixture {
+	t.Helper()
+	encodingConfig := evmenc.MakeConfig()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	f := &blockQueryFixture{sender: common.BytesToAddress(priv.PubKey().Address().Bytes())}
+	chainID := fakeEvmKeeper{}.ChainID()
+	recipient := common.BigToAddress(big.NewInt(1))
+	newTx := func(nonce, gas uint64) cmttypes.Tx {
+		msg := evmtypes.NewTx(chainID, nonce, &recipient, big.NewInt(1), gas, big.NewInt(1), nil, nil, nil, nil)
+		msg.From = f.sender.Bytes()
+		require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+		tx, err := msg.BuildTx(encodingConfig.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+		require.NoError(t, err)
+		bz, err := encodingConfig.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	newBlock := func(height int64, txs ...cmttypes.Tx) *cmttypes.Block {
+		return &cmttypes.Block{
+			Header: cmttypes.Header{ChainID: "cronos_777-1", Height: height, ValidatorsHash: []byte{1}},
+			Data:   cmttypes.Data{Txs: txs},
+		}
+	}
+
+	f.node = fakeNode{
+		latest: 5,
+		blocks: map[int64]*cmttypes.Block{
+			2: newBlock(2),
+			3: newBlock(3, newTx(0, 21000)),
+			4: newBlock(4, newTx(1, 30000)),
+			5: newBlock(5),
+		},
+		results: map[int64]*coretypes.ResultBlockResults{
+			2: {Height: 2},
+			3: {Height: 3, TxsResults: []*abci.ExecTxResult{{Code: 0, GasUsed: 21000}}},
+			4: {Height: 4, TxsResults: []*abci.ExecTxResult{{
+				Code: 11, GasUsed: 30000, Log: ExceedBlockGasLimitError + " 30000, gasUsed: 31000",
+			}}},
+		},
+	}
+	queryContext := func(height int64, _ bool) (sdk.Context, error) {
+		if height == 2 {
+			return sdk.Context{}, fmt.Errorf("state of height %d is pruned", height)
+		}
+		return sdk.Context{}, nil
+	}
+	clientCtx := client.Context{}.WithClient(f.node).WithTxConfig(encodingConfig.TxConfig)
+	f.server = NewServer(clientCtx, queryContext, fakeQueryServer{requests: &f.requests}, fakeEvmKeeper{})
+	return f
+}
+
+func TestTransactionReceiptsByBlockNotFound(t *testing.T) {
+	f := newBlockQueryFixture(t)
+	for _, tc := range []struct {
+		name   string
+		height int64
+		code   codes.Code
+	}{
+		{"negative height", -1, codes.InvalidArgument},
+		{"future height", 10, codes.NotFound},
+		{"pruned block results", 5, codes.NotFound},
+		{"latest block with pruned results", 0, codes.NotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.server.TransactionReceiptsByBlock(context.Background(), &types.QueryTransactionReceiptsByBlockRequest{Height: tc.height})
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
+func TestTransactionReceiptsByBlockEmpty(t *testing.T) {
+	f := newBlockQueryFixture(t)
+	// the evm parameters of block 2 are pruned
+	_, err := f.server.TransactionReceiptsByBlock(context.Background(), &types.QueryTransactionReceiptsByBlockRequest{Height: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	f.node.blocks[1] = &cmttypes.Block{Header: cmttypes.Header{Height: 1, ValidatorsHash: []byte{1}}}
+	f.node.results[1] = &coretypes.ResultBlockResults{Height: 1}
+	rsp, err := f.server.TransactionReceiptsByBlock(context.Background(), &types.QueryTransactionReceiptsByBlockRequest{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rsp.Height)
+	require.Equal(t, common.BytesToHash(f.node.blocks[1].Hash()).Hex(), rsp.BlockHash)
+	require.Empty(t, rsp.Receipts)
+}
+
+func TestReplayBlockByHeight(t *testing.T) {
+	f := newBlockQueryFixture(t)
+
+	// the state before block 3 is pruned
+	_, err := f.server.ReplayBlockByHeight(context.Background(), &types.QueryReplayBlockByHeightRequest{Height: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Empty(t, f.requests)
+
+	rsp, err := f.server.ReplayBlockByHeight(context.Background(), &types.QueryReplayBlockByHeightRequest{Height: 4})
+	require.NoError(t, err)
+	require.Len(t, f.requests, 1)
+	require.Equal(t, int64(4), f.requests[0].BlockNumber)
+	require.Len(t, f.requests[0].Msgs, 1)
+	require.Len(t, rsp.Receipts, 1)
+	receipt := rsp.Receipts[0]
+	require.Equal(t, f.sender.Hex(), receipt.From)
+	require.Equal(t, uint64(ethtypes.ReceiptStatusSuccessful), receipt.Status)
+	require.Equal(t, uint64(21000), receipt.GasUsed)
+
+	// after the upgrade the tx exceeding the block gas limit is reverted and charged with its gas limit
+	rsp, err = f.server.ReplayBlockByHeight(context.Background(), &types.QueryReplayBlockByHeightRequest{Height: 4, PostUpgrade: true})
+	require.NoError(t, err)
+	receipt = rsp.Receipts[0]
+	require.Equal(t, uint64(ethtypes.ReceiptStatusFailed), receipt.Status)
+	require.Equal(t, uint64(30000), receipt.GasUsed)
+
+	_, err = f.server.ReplayBlockByHeight(context.Background(), &types.QueryReplayBlockByHeightRequest{Height: 10})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
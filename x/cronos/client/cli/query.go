@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/crypto-org-chain/cronos/x/cronos/types"
@@ -12,6 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+// FlagPostUpgrade is the flag of the replay-block command
+const FlagPostUpgrade = "post-upgrade"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group cronos queries under a subcommand
@@ -28,6 +32,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetDenomByContractCmd(),
 		QueryParamsCmd(),
 		GetPermissions(),
+		GetPendingRefundConversionsCmd(),
+		GetDustCmd(),
+		GetLogActionsCmd(),
+		GetIbcTransferStatusCmd(),
+		GetTransactionReceiptsByBlockCmd(),
+		GetReplayBlockCmd(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -158,3 +168,197 @@ func GetPermissions() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPendingRefundConversionsCmd queries the failed refund conversions waiting to be retried for an address
+func GetPendingRefundConversionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-refund-conversions [addr]",
+		Short: "Gets the failed refund conversions waiting to be retried for an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingRefundConversionsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.PendingRefundConversions(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetDustCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dust [addr]",
+		Short: "Gets the dust balances of an address which can be claimed back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDustRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.Dust(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetLogActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-actions",
+		Short: "Gets the native actions bound to evm logs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LogActions(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryLogActionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetIbcTransferStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-transfer-status [tx-hash]",
+		Short: "Gets the status of the ibc transfers sent by an eth or cosmos tx, requires the tx indexer of the node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewIbcTransferQueryClient(clientCtx)
+
+			req := &types.QueryIbcTransferStatusRequest{
+				TxHash: args[0],
+			}
+
+			res, err := queryClient.IbcTransferStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetTransactionReceiptsByBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transaction-receipts [height]",
+		Short: "Gets the receipts of the eth transactions included in the block, 0 means the latest block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransactionReceiptsByBlockRequest{
+				Height: height,
+			}
+
+			res, err := queryClient.TransactionReceiptsByBlock(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetReplayBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block [height]",
+		Short: "Replays the eth transactions included in the block and gets the receipts, 0 means the latest block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			postUpgrade, err := cmd.Flags().GetBool(FlagPostUpgrade)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryReplayBlockByHeightRequest{
+				Height:      height,
+				PostUpgrade: postUpgrade,
+			}
+
+			res, err := queryClient.ReplayBlockByHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagPostUpgrade, false, "treat the tx that exceeded the block gas limit as reverted, as after the v0.7.0 upgrade")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -44,6 +44,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateTokenMapping())
 	cmd.AddCommand(CmdUpdatePermissions())
 	cmd.AddCommand(CmdStoreBlockList())
+	cmd.AddCommand(CmdClaimDust())
 	cmd.AddCommand(MigrateGenesisCmd())
 	return cmd
 }
@@ -289,12 +290,19 @@ func CmdUpdatePermissions() *cobra.Command {
 	return cmd
 }
 
+// FlagVerifyCoverage makes the chain verify the block list covers every bonded validator.
+const FlagVerifyCoverage = "verify-coverage"
+
 // CmdStoreBlockList returns a CLI command handler for updating cronos permissions
 func CmdStoreBlockList() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "store-block-list [encrypted-block-list-file]",
 		Short: "Store encrypted block list",
-		Args:  cobra.ExactArgs(1),
+		Long: `Store encrypted block list, with --verify-coverage the chain rejects it unless every bonded validator has a
+valid encryption key and the block list has an age stanza for each of them. The stanzas of the ssh keys are matched to
+the registered keys by their tag, the x25519, hybrid and plugin stanzas don't reveal their recipients, so they are only
+checked by count, a block list encrypted to other x25519 keys isn't detected.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -313,7 +321,13 @@ func CmdStoreBlockList() *cobra.Command {
 				return err
 			}
 
+			verifyCoverage, err := cmd.Flags().GetBool(FlagVerifyCoverage)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgStoreBlockList(clientCtx.GetFromAddress().String(), blob)
+			msg.VerifyCoverage = verifyCoverage
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -322,7 +336,33 @@ func CmdStoreBlockList() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagVerifyCoverage, false, "reject the block list unless it has a stanza for every bonded validator, the x25519 stanzas are only counted")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdClaimDust returns a CLI command handler for refunding the dust balances of the sender
+func CmdClaimDust() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-dust",
+		Short: "Refund the remainders kept as dust by the previous transfers of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimDust(clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+interface IE2EEModule {
+    function registerKey(string calldata) external returns (bool);
+    function keyOf(address) external view returns (string memory);
+    function keysOf(address[] calldata) external view returns (string[] memory);
+}
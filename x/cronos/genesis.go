@@ -49,6 +49,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, entry := range genState.PendingRefundConversions {
+		if err := k.SetPendingRefundConversion(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, dust := range genState.DustBalances {
+		if err := k.SetDustBalance(ctx, dust); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, action := range genState.LogActions {
+		if err := k.SetLogAction(ctx, action); err != nil {
+			panic(err)
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 
 	// this line is used by starport scaffolding # ibc/genesis/init
@@ -66,5 +84,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:            k.GetParams(ctx),
 		ExternalContracts: k.GetExternalContracts(ctx),
 		AutoContracts:     k.GetAutoContracts(ctx),
+
+		PendingRefundConversions: k.GetAllPendingRefundConversions(ctx),
+		DustBalances:             k.GetAllDustBalances(ctx),
+		LogActions:               k.GetAllLogActions(ctx),
 	}
 }
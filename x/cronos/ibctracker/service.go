@@ -0,0 +1,46 @@
+package ibctracker
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+var _ types.IbcTransferQueryServer = service{}
+
+// service implements the IbcTransferQuery gRPC service with the tx indexer of the node.
+type service struct {
+	clientCtx client.Context
+}
+
+// IbcTransferStatus implements the IbcTransferQuery/IbcTransferStatus gRPC method
+func (s service) IbcTransferStatus(ctx context.Context, req *types.QueryIbcTransferStatusRequest) (*types.QueryIbcTransferStatusResponse, error) {
+	if req == nil || req.TxHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+	transfers, err := QueryTransferStatus(ctx, s.clientCtx, req.TxHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryIbcTransferStatusResponse{
+		Transfers: transfers,
+	}, nil
+}
+
+// RegisterIbcTransferService registers the IbcTransferQuery service on the gRPC router.
+func RegisterIbcTransferService(qrt gogogrpc.Server, clientCtx client.Context) {
+	types.RegisterIbcTransferQueryServer(qrt, service{clientCtx: clientCtx})
+}
+
+// RegisterGRPCGatewayRoutes mounts the IbcTransferQuery service's GRPC-gateway routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterIbcTransferQueryHandlerClient(context.Background(), mux, types.NewIbcTransferQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,244 @@
+package ibctracker
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgIndexAttribute is appended by baseapp to the events emitted by each msg.
+const msgIndexAttribute = "msg_index"
+
+// QueryTransferStatus finds the ibc transfer packets sent by the tx and resolves their status from the tx indexer,
+// the tx hash can be either an eth tx hash prefixed with 0x or a cosmos tx hash.
+func QueryTransferStatus(ctx context.Context, clientCtx client.Context, txHash string) ([]types.IbcTransfer, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	sendTx, err := findTx(ctx, node, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingRefunds := queryPendingRefunds(ctx, clientCtx)
+	transfers := []types.IbcTransfer{}
+	for _, event := range sendTx.TxResult.Events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		attrs := eventAttributes(event)
+		if attrs[channeltypes.AttributeKeySrcPort] != transfertypes.PortID {
+			continue
+		}
+		transfer, err := newIbcTransfer(attrs)
+		if err != nil {
+			return nil, err
+		}
+		transfer.SendTxHash = fmt.Sprintf("%X", sendTx.Hash)
+		if err := resolveStatus(ctx, node, pendingRefunds, &transfer); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+// findTx finds the tx by the eth tx hash or the cosmos tx hash.
+func findTx(ctx context.Context, node client.CometRPC, txHash string) (*coretypes.ResultTx, error) {
+	if strings.HasPrefix(txHash, "0x") || strings.HasPrefix(txHash, "0X") {
+		query := fmt.Sprintf("%s.%s='%s'",
+			evmtypes.EventTypeEthereumTx, evmtypes.AttributeKeyEthereumTxHash, common.HexToHash(txHash).Hex())
+		txs, err := searchTxs(ctx, node, query)
+		if err != nil {
+			return nil, err
+		}
+		if len(txs) == 0 {
+			return nil, fmt.Errorf("eth tx not found: %s", txHash)
+		}
+		return txs[0], nil
+	}
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash %s: %w", txHash, err)
+	}
+	return node.Tx(ctx, hash, false)
+}
+
+// searchTxs returns the first tx matching the query, the packet results are unique so one is enough.
+func searchTxs(ctx context.Context, node client.CometRPC, query string) ([]*coretypes.ResultTx, error) {
+	page, perPage := 1, 1
+	res, err := node.TxSearch(ctx, query, false, &page, &perPage, "asc")
+	if err != nil {
+		return nil, err
+	}
+	return res.Txs, nil
+}
+
+// newIbcTransfer decodes the transfer from the attributes of the send_packet event.
+func newIbcTransfer(attrs map[string]string) (types.IbcTransfer, error) {
+	sequence, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return types.IbcTransfer{}, fmt.Errorf("invalid packet sequence: %w", err)
+	}
+	bz, err := hex.DecodeString(attrs[channeltypes.AttributeKeyDataHex])
+	if err != nil {
+		return types.IbcTransfer{}, fmt.Errorf("invalid packet data: %w", err)
+	}
+	data, err := transfertypes.UnmarshalPacketData(bz, transfertypes.V1, "")
+	if err != nil {
+		return types.IbcTransfer{}, err
+	}
+	return types.IbcTransfer{
+		SourcePort:         attrs[channeltypes.AttributeKeySrcPort],
+		SourceChannel:      attrs[channeltypes.AttributeKeySrcChannel],
+		Sequence:           sequence,
+		DestinationPort:    attrs[channeltypes.AttributeKeyDstPort],
+		DestinationChannel: attrs[channeltypes.AttributeKeyDstChannel],
+		Denom:              data.Token.Denom.Path(),
+		Amount:             data.Token.Amount,
+		Sender:             data.Sender,
+		Receiver:           data.Receiver,
+		Status:             types.IbcTransferStatusPending,
+	}, nil
+}
+
+// pendingRefundsFn returns the pending refund conversions of the sender at the latest height.
+type pendingRefundsFn func(sender string) ([]types.PendingRefundConversion, error)
+
+// queryPendingRefunds returns the pending refund conversions with the cronos query service.
+func queryPendingRefunds(ctx context.Context, clientCtx client.Context) pendingRefundsFn {
+	queryClient := types.NewQueryClient(clientCtx)
+	return func(sender string) ([]types.PendingRefundConversion, error) {
+		rsp, err := queryClient.PendingRefundConversions(ctx, &types.QueryPendingRefundConversionsRequest{Address: sender})
+		if err != nil {
+			return nil, err
+		}
+		return rsp.Conversions, nil
+	}
+}
+
+// resolveStatus searches the acknowledgement or the timeout of the packet, the transfer stays pending if none found.
+func resolveStatus(ctx context.Context, node client.CometRPC, pendingRefunds pendingRefundsFn, transfer *types.IbcTransfer) error {
+	for _, eventType := range []string{channeltypes.EventTypeAcknowledgePacket, channeltypes.EventTypeTimeoutPacket} {
+		query := fmt.Sprintf("%s.%s='%d' AND %s.%s='%s' AND %s.%s='%s'",
+			eventType, channeltypes.AttributeKeySequence, transfer.Sequence,
+			eventType, channeltypes.AttributeKeySrcChannel, transfer.SourceChannel,
+			eventType, channeltypes.AttributeKeySrcPort, transfer.SourcePort,
+		)
+		txs, err := searchTxs(ctx, node, query)
+		if err != nil {
+			return err
+		}
+		if len(txs) == 0 {
+			continue
+		}
+		resultTx := txs[0]
+		transfer.ResultTxHash = fmt.Sprintf("%X", resultTx.Hash)
+
+		// a relayer tx can contain many packets, only check the events of the same msg.
+		return applyPacketResult(transfer, packetMsgEvents(resultTx.TxResult.Events, eventType, transfer), eventType, pendingRefunds)
+	}
+	return nil
+}
+
+// applyPacketResult sets the status of the transfer from the events of the msg which handled the packet result, a
+// failed refund conversion is only reported while it's still pending, the retries may have converted it since.
+func applyPacketResult(transfer *types.IbcTransfer, events []abci.Event, eventType string, pendingRefunds pendingRefundsFn) error {
+	if eventType == channeltypes.EventTypeTimeoutPacket {
+		transfer.Status = types.IbcTransferStatusRefunded
+	} else {
+		transfer.Status = types.IbcTransferStatusAcked
+		for _, event := range events {
+			if event.Type != transfertypes.EventTypePacket {
+				continue
+			}
+			if ackErr, ok := eventAttributes(event)[transfertypes.AttributeKeyAckError]; ok {
+				transfer.Status = types.IbcTransferStatusRefunded
+				transfer.Error = ackErr
+			}
+		}
+	}
+	for _, event := range events {
+		if event.Type != types.EventTypeRefundConversionFailed {
+			continue
+		}
+		attrs := eventAttributes(event)
+		pending, err := isRefundPending(attrs[types.AttributeKeySender], attrs[sdk.AttributeKeyAmount], pendingRefunds)
+		if err != nil {
+			return err
+		}
+		if pending {
+			transfer.Status = types.IbcTransferStatusRefundConversionFailed
+			transfer.Error = attrs[types.AttributeKeyError]
+		}
+	}
+	return nil
+}
+
+// isRefundPending returns if the failed refund conversion of the amount is still waiting to be retried.
+func isRefundPending(sender, amount string, pendingRefunds pendingRefundsFn) (bool, error) {
+	coin, err := sdk.ParseCoinNormalized(amount)
+	if err != nil {
+		return false, fmt.Errorf("invalid refund amount %s: %w", amount, err)
+	}
+	conversions, err := pendingRefunds(sender)
+	if err != nil {
+		return false, err
+	}
+	for _, conversion := range conversions {
+		if conversion.Amount.Denom == coin.Denom {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// packetMsgEvents returns the events emitted by the msg which handled the packet result.
+func packetMsgEvents(events []abci.Event, eventType string, transfer *types.IbcTransfer) []abci.Event {
+	msgIndex := ""
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		attrs := eventAttributes(event)
+		if attrs[channeltypes.AttributeKeySequence] == strconv.FormatUint(transfer.Sequence, 10) &&
+			attrs[channeltypes.AttributeKeySrcChannel] == transfer.SourceChannel &&
+			attrs[channeltypes.AttributeKeySrcPort] == transfer.SourcePort {
+			msgIndex = attrs[msgIndexAttribute]
+			break
+		}
+	}
+	if msgIndex == "" {
+		return nil
+	}
+	var result []abci.Event
+	for _, event := range events {
+		if eventAttributes(event)[msgIndexAttribute] == msgIndex {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
+// eventAttributes converts the attributes of the event to a map.
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
@@ -0,0 +1,163 @@
+package ibctracker
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	transfertypes "github.com/cosmos/ibc-go/v11/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newEvent(eventType string, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+func TestNewIbcTransfer(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("transfer/channel-0/uatom", "100", "crc1sender", "cosmos1receiver", "")
+	attrs := map[string]string{
+		channeltypes.AttributeKeySequence:   "5",
+		channeltypes.AttributeKeySrcPort:    transfertypes.PortID,
+		channeltypes.AttributeKeySrcChannel: "channel-0",
+		channeltypes.AttributeKeyDstPort:    transfertypes.PortID,
+		channeltypes.AttributeKeyDstChannel: "channel-1",
+		channeltypes.AttributeKeyDataHex:    hex.EncodeToString(data.GetBytes()),
+	}
+
+	transfer, err := newIbcTransfer(attrs)
+	require.NoError(t, err)
+	require.Equal(t, types.IbcTransfer{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-0",
+		Sequence:           5,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-1",
+		Denom:              "transfer/channel-0/uatom",
+		Amount:             "100",
+		Sender:             "crc1sender",
+		Receiver:           "cosmos1receiver",
+		Status:             types.IbcTransferStatusPending,
+	}, transfer)
+
+	attrs[channeltypes.AttributeKeySequence] = "invalid"
+	_, err = newIbcTransfer(attrs)
+	require.Error(t, err)
+}
+
+func TestPacketMsgEvents(t *testing.T) {
+	transfer := &types.IbcTransfer{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-0",
+		Sequence:      5,
+	}
+	ackEvent := func(sequence, msgIndex string) abci.Event {
+		return newEvent(channeltypes.EventTypeAcknowledgePacket,
+			channeltypes.AttributeKeySequence, sequence,
+			channeltypes.AttributeKeySrcPort, transfertypes.PortID,
+			channeltypes.AttributeKeySrcChannel, "channel-0",
+			msgIndexAttribute, msgIndex,
+		)
+	}
+
+	testCases := []struct {
+		name   string
+		events []abci.Event
+		expLen int
+		expErr string
+	}{
+		{
+			"packet not found",
+			[]abci.Event{ackEvent("4", "0")},
+			0,
+			"",
+		},
+		{
+			"only the events of the same msg",
+			[]abci.Event{
+				ackEvent("4", "0"),
+				newEvent(transfertypes.EventTypePacket, transfertypes.AttributeKeyAckError, "other", msgIndexAttribute, "0"),
+				ackEvent("5", "1"),
+				newEvent(transfertypes.EventTypePacket, transfertypes.AttributeKeyAckError, "failed", msgIndexAttribute, "1"),
+			},
+			2,
+			"failed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			events := packetMsgEvents(tc.events, channeltypes.EventTypeAcknowledgePacket, transfer)
+			require.Len(t, events, tc.expLen)
+			if tc.expErr != "" {
+				require.Equal(t, tc.expErr, eventAttributes(events[1])[transfertypes.AttributeKeyAckError])
+			}
+		})
+	}
+}
+
+func TestApplyPacketResultRefundConversion(t *testing.T) {
+	const sender = "crc1sender"
+	events := []abci.Event{
+		newEvent(transfertypes.EventTypePacket, transfertypes.AttributeKeyAckError, "failed", msgIndexAttribute, "0"),
+		newEvent(types.EventTypeRefundConversionFailed,
+			types.AttributeKeySender, sender,
+			sdk.AttributeKeyAmount, "100ibc/ABCD",
+			types.AttributeKeyError, "conversion failed",
+			msgIndexAttribute, "0",
+		),
+	}
+	pendingOf := func(conversions ...types.PendingRefundConversion) pendingRefundsFn {
+		return func(address string) ([]types.PendingRefundConversion, error) {
+			require.Equal(t, sender, address)
+			return conversions, nil
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		pending   pendingRefundsFn
+		expStatus string
+		expErr    string
+	}{
+		{
+			"still pending",
+			pendingOf(types.PendingRefundConversion{Sender: sender, Amount: sdk.NewInt64Coin("ibc/ABCD", 100)}),
+			types.IbcTransferStatusRefundConversionFailed,
+			"conversion failed",
+		},
+		{
+			"retried then converted",
+			pendingOf(),
+			types.IbcTransferStatusRefunded,
+			"failed",
+		},
+		{
+			"another denom pending",
+			pendingOf(types.PendingRefundConversion{Sender: sender, Amount: sdk.NewInt64Coin("ibc/EFGH", 100)}),
+			types.IbcTransferStatusRefunded,
+			"failed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transfer := &types.IbcTransfer{}
+			require.NoError(t, applyPacketResult(transfer, events, channeltypes.EventTypeAcknowledgePacket, tc.pending))
+			require.Equal(t, tc.expStatus, transfer.Status)
+			require.Equal(t, tc.expErr, transfer.Error)
+		})
+	}
+
+	// the status isn't guessed if the pending conversions can't be queried
+	err := applyPacketResult(&types.IbcTransfer{}, events, channeltypes.EventTypeTimeoutPacket, func(string) ([]types.PendingRefundConversion, error) {
+		return nil, errors.New("unavailable")
+	})
+	require.Error(t, err)
+}
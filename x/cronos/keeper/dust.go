@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetDustBalance returns the dust balance of the address for the denom
+func (k Keeper) GetDustBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.DustBalanceKey(addr, denom))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	var dust types.DustBalance
+	k.cdc.MustUnmarshal(bz, &dust)
+	return dust.Amount.Amount
+}
+
+// SetDustBalance stores the dust balance of an address, a zero amount removes it
+func (k Keeper) SetDustBalance(ctx sdk.Context, dust types.DustBalance) error {
+	addr, err := sdk.AccAddressFromBech32(dust.Address)
+	if err != nil {
+		return err
+	}
+	key := types.DustBalanceKey(addr, dust.Amount.Denom)
+	if dust.Amount.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return nil
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&dust))
+	return nil
+}
+
+// GetDustBalances returns all the dust balances of the address
+func (k Keeper) GetDustBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DustBalancesKey(addr))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	coins := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		var dust types.DustBalance
+		k.cdc.MustUnmarshal(iter.Value(), &dust)
+		coins = coins.Add(dust.Amount)
+	}
+	return coins
+}
+
+// GetAllDustBalances returns the dust balances of all the addresses
+func (k Keeper) GetAllDustBalances(ctx sdk.Context) (out []types.DustBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDust)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var dust types.DustBalance
+		k.cdc.MustUnmarshal(iter.Value(), &dust)
+		out = append(out, dust)
+	}
+	return out
+}
+
+// ClaimDust refunds all the dust balances of the address from the module account
+func (k Keeper) ClaimDust(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	coins := k.GetDustBalances(ctx, addr)
+	if coins.IsZero() {
+		return coins, nil
+	}
+	for _, coin := range coins {
+		ctx.KVStore(k.storeKey).Delete(types.DustBalanceKey(addr, coin.Denom))
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/x/cronos/keeper"
+	keepertest "github.com/crypto-org-chain/cronos/x/cronos/keeper/mock"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestIbcTransferCoinsDust() {
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	evmDenom := suite.evmParam.EvmDenom
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		amount    sdkmath.Int
+		postCheck func()
+	}{
+		{
+			"remainder is kept as dust",
+			func() {},
+			sdkmath.NewInt(1230000000123),
+			func() {
+				suite.Require().Equal(sdkmath.NewInt(123), suite.app.CronosKeeper.GetDustBalance(suite.ctx, address, evmDenom))
+				suite.Require().True(suite.GetBalance(address, evmDenom).Amount.IsZero())
+				suite.Require().Equal(sdkmath.NewInt(123), suite.GetBalance(address, types.IbcCroDenomDefaultValue).Amount)
+			},
+		},
+		{
+			"dust is added to the next transfer",
+			func() {
+				suite.Require().NoError(suite.app.CronosKeeper.SetDustBalance(suite.ctx, types.DustBalance{
+					Address: address.String(),
+					Amount:  sdk.NewCoin(evmDenom, sdkmath.NewInt(9999999000)),
+				}))
+				suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewInt(9999999000)))))
+			},
+			sdkmath.NewInt(1230000001000),
+			func() {
+				suite.Require().True(suite.app.CronosKeeper.GetDustBalance(suite.ctx, address, evmDenom).IsZero())
+				suite.Require().True(suite.GetBalance(address, evmDenom).Amount.IsZero())
+				suite.Require().Equal(sdkmath.NewInt(124), suite.GetBalance(address, types.IbcCroDenomDefaultValue).Amount)
+			},
+		},
+		{
+			"amount too small is kept by the sender",
+			func() {},
+			sdkmath.NewInt(123),
+			func() {
+				suite.Require().True(suite.app.CronosKeeper.GetDustBalance(suite.ctx, address, evmDenom).IsZero())
+				suite.Require().Equal(sdkmath.NewInt(123), suite.GetBalance(address, evmDenom).Amount)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetTKey(types.TStoreKey),
+				suite.app.BankKeeper,
+				keepertest.IbcKeeperMock{},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				suite.app.E2EEKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(sdk.NewCoin(evmDenom, tc.amount))))
+			suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(124)))))
+
+			tc.malleate()
+			err := suite.app.CronosKeeper.IbcTransferCoins(
+				suite.ctx, address.String(), "to", sdk.NewCoins(sdk.NewCoin(evmDenom, tc.amount)), "channel-0",
+			)
+			suite.Require().NoError(err)
+			tc.postCheck()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimDust() {
+	suite.SetupTest()
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	dust := sdk.NewCoin(suite.evmParam.EvmDenom, sdkmath.NewInt(123))
+
+	keeper := suite.app.CronosKeeper
+	suite.Require().NoError(keeper.SetDustBalance(suite.ctx, types.DustBalance{Address: address.String(), Amount: dust}))
+	suite.Require().NoError(suite.MintCoinsToModule(types.ModuleName, sdk.NewCoins(dust)))
+
+	rsp, err := keeper.Dust(suite.ctx, &types.QueryDustRequest{Address: address.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(dust), rsp.Dust)
+
+	claimed, err := keeper.ClaimDust(suite.ctx, address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(dust), claimed)
+	suite.Require().Equal(dust, suite.GetBalance(address, dust.Denom))
+	suite.Require().True(keeper.GetDustBalances(suite.ctx, address).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestConvertVouchersWithDenomScaling() {
+	suite.SetupTest()
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	targetDenom := "aatom"
+
+	for denom, exponent := range map[string]uint32{CorrectIbcDenom: 6, targetDenom: 18} {
+		suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+			Base:       denom,
+			Display:    "atom",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "atom", Exponent: exponent}},
+		})
+	}
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.DenomScalings = []types.DenomScaling{{Denom: CorrectIbcDenom, TargetDenom: targetDenom}}
+	suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+	coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(123)))
+	suite.Require().NoError(suite.MintCoins(address, coins))
+	suite.Require().NoError(suite.app.CronosKeeper.ConvertVouchersToEvmCoins(suite.ctx, address.String(), coins))
+
+	suite.Require().True(suite.GetBalance(address, CorrectIbcDenom).Amount.IsZero())
+	suite.Require().Equal(sdkmath.NewInt(123000000000000), suite.GetBalance(address, targetDenom).Amount)
+	suite.Require().True(suite.app.CronosKeeper.IsScaledDenom(suite.ctx, CorrectIbcDenom))
+}
@@ -12,6 +12,8 @@ import (
 // LogProcessEvmHook is an evm hook that convert specific contract logs into native module calls
 type LogProcessEvmHook struct {
 	handlers map[common.Hash]types.EvmLogHandler
+	// dispatches the logs not handled by the handlers, optional
+	dispatcher types.EvmLogDispatcher
 }
 
 func NewLogProcessEvmHook(handlers ...types.EvmLogHandler) *LogProcessEvmHook {
@@ -24,6 +26,12 @@ func NewLogProcessEvmHook(handlers ...types.EvmLogHandler) *LogProcessEvmHook {
 	}
 }
 
+// SetLogDispatcher sets the dispatcher of the logs which are not handled by the static handlers
+func (h *LogProcessEvmHook) SetLogDispatcher(dispatcher types.EvmLogDispatcher) *LogProcessEvmHook {
+	h.dispatcher = dispatcher
+	return h
+}
+
 // PostTxProcessing implements EvmHook interface
 func (h LogProcessEvmHook) PostTxProcessing(ctx sdk.Context, _ *core.Message, receipt *ethtypes.Receipt) error {
 	addLogToReceiptFunc := newFuncAddLogToReceipt(receipt)
@@ -33,6 +41,12 @@ func (h LogProcessEvmHook) PostTxProcessing(ctx sdk.Context, _ *core.Message, re
 		}
 		handler, ok := h.handlers[log.Topics[0]]
 		if !ok {
+			if h.dispatcher == nil {
+				continue
+			}
+			if err := h.dispatcher.Dispatch(ctx, log.Address, log.Topics, log.Data, addLogToReceiptFunc); err != nil {
+				return err
+			}
 			continue
 		}
 		err := handler.Handle(ctx, log.Address, log.Topics, log.Data, addLogToReceiptFunc)
@@ -0,0 +1,62 @@
+package evmhandler
+
+import (
+	cronoskeeper "github.com/crypto-org-chain/cronos/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const HandlerErrorEventName = "__CronosHandlerError"
+
+// HandlerErrorEvent represent the signature of
+// `event __CronosHandlerError(bytes32 eventId, string reason)`
+var HandlerErrorEvent abi.Event
+
+func init() {
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	stringType, _ := abi.NewType("string", "", nil)
+
+	HandlerErrorEvent = abi.NewEvent(
+		HandlerErrorEventName,
+		HandlerErrorEventName,
+		false,
+		abi.Arguments{abi.Argument{
+			Name:    "eventId",
+			Type:    bytes32Type,
+			Indexed: false,
+		}, abi.Argument{
+			Name:    "reason",
+			Type:    stringType,
+			Indexed: false,
+		}},
+	)
+}
+
+// reportMalformedLog handles a log which matches the signature of a handler but can't be processed,
+// the tx is reverted if StrictLogHandlers is enabled, otherwise a `__CronosHandlerError` log is added
+// to the receipt so the failure can be detected by the dapps.
+func reportMalformedLog(
+	ctx sdk.Context,
+	cronosKeeper cronoskeeper.Keeper,
+	contract common.Address,
+	eventID common.Hash,
+	reason string,
+	addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte),
+) error {
+	cronosKeeper.Logger(ctx).Error(reason, "contract", contract, "event", eventID)
+	if cronosKeeper.GetParams(ctx).StrictLogHandlers {
+		return errorsmod.Wrapf(types.ErrMalformedLog, "contract %s event %s: %s", contract, eventID, reason)
+	}
+
+	data, err := HandlerErrorEvent.Inputs.Pack(eventID, reason)
+	if err != nil {
+		return err
+	}
+	addLogToReceipt(contract, HandlerErrorEvent.ID, data)
+	return nil
+}
@@ -0,0 +1,186 @@
+package evmhandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	cronoskeeper "github.com/crypto-org-chain/cronos/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.EvmLogDispatcher = LogActionDispatcher{}
+
+// MsgRouter defines the interface to route the native messages to their handlers
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// LogActionDispatcher executes the native messages bound to evm logs by the governance managed log actions,
+// the messages are signed by the contract emitting the log.
+type LogActionDispatcher struct {
+	cdc          codec.Codec
+	router       MsgRouter
+	cronosKeeper cronoskeeper.Keeper
+}
+
+func NewLogActionDispatcher(cdc codec.Codec, router MsgRouter, cronosKeeper cronoskeeper.Keeper) *LogActionDispatcher {
+	return &LogActionDispatcher{
+		cdc:          cdc,
+		router:       router,
+		cronosKeeper: cronosKeeper,
+	}
+}
+
+// Dispatch implements EvmLogDispatcher interface
+func (d LogActionDispatcher) Dispatch(
+	ctx sdk.Context,
+	contract common.Address,
+	topics []common.Hash,
+	data []byte,
+	_ func(contractAddress common.Address, logSig common.Hash, logData []byte),
+) error {
+	if len(topics) == 0 {
+		return nil
+	}
+	action, found := d.cronosKeeper.GetLogAction(ctx, contract, topics[0])
+	if !found {
+		return nil
+	}
+	if err := d.cronosKeeper.AuthorizeLogHandler(ctx, types.LogHandlerLogAction, contract, sdkmath.OneInt()); err != nil {
+		return err
+	}
+
+	msg, err := d.buildMsg(ctx, action, contract, topics, data)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrLogActionInvalid, "contract %s: %s", contract, err)
+	}
+
+	signers, _, err := d.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return fmt.Errorf("fail to get signers %w", err)
+	}
+	if len(signers) != 1 || common.BytesToAddress(signers[0]) != contract {
+		return fmt.Errorf("log action message of contract %s must be signed by the contract", contract)
+	}
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	handler := d.router.Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("no handler for log action message %s", sdk.MsgTypeURL(msg))
+	}
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return err
+	}
+	for _, event := range res.GetEvents() {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+	return nil
+}
+
+// buildMsg decodes the log with the event abi and renders the message template of the action with its arguments
+func (d LogActionDispatcher) buildMsg(
+	ctx sdk.Context, action types.LogAction, contract common.Address, topics []common.Hash, data []byte,
+) (sdk.Msg, error) {
+	event, err := action.ParseEvent()
+	if err != nil {
+		return nil, err
+	}
+	template, err := action.ParseMsgTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	args := make(map[string]interface{})
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(args, data); err != nil {
+		return nil, fmt.Errorf("log signature matches but failed to decode: %w", err)
+	}
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if len(topics)-1 != len(indexed) {
+		return nil, fmt.Errorf("log signature matches but wrong number of indexed events: %d", len(topics)-1)
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, topics[1:]); err != nil {
+		return nil, fmt.Errorf("log signature matches but failed to decode topics: %w", err)
+	}
+
+	rendered, err := types.RenderLogActionTemplate(template, func(name string) (string, error) {
+		switch name {
+		case types.LogActionContractPlaceholder:
+			return sdk.AccAddress(contract.Bytes()).String(), nil
+		case types.LogActionDenomPlaceholder:
+			denom, found := d.cronosKeeper.GetDenomByContract(ctx, contract)
+			if !found {
+				return "", fmt.Errorf("contract %s is not connected to native token", contract)
+			}
+			return denom, nil
+		}
+		value, ok := args[name]
+		if !ok {
+			return "", fmt.Errorf("unknown log action placeholder: %s", name)
+		}
+		return formatLogActionArg(value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, err
+	}
+	var msg sdk.Msg
+	if err := d.cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+		return nil, fmt.Errorf("invalid log action message: %w", err)
+	}
+	return msg, nil
+}
+
+// formatLogActionArg formats the decoded event argument as the string value of the native message,
+// addresses are converted to bech32 accounts.
+func formatLogActionArg(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case common.Address:
+		return sdk.AccAddress(v.Bytes()).String(), nil
+	case *big.Int:
+		return v.String(), nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case common.Hash:
+		return v.Hex(), nil
+	case []byte:
+		return hexutil.Encode(v), nil
+	case [32]byte:
+		return hexutil.Encode(v[:]), nil
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(value), nil
+	default:
+		return "", fmt.Errorf("unsupported log action argument type %T", value)
+	}
+}
@@ -17,7 +17,7 @@ import (
 
 var _ types.EvmLogHandler = SendCroToIbcHandler{}
 
-const SendCroToIbcEventName = "__CronosSendCroToIbc"
+const SendCroToIbcEventName = types.LogHandlerSendCroToIbc
 
 // SendCroToIbcEvent represent the signature of
 // `event __CronosSendCroToIbc(string recipient, uint256 amount)`
@@ -70,7 +70,7 @@ func (h SendCroToIbcHandler) Handle(
 	contract common.Address,
 	topics []common.Hash,
 	data []byte,
-	_ func(contractAddress common.Address, logSig common.Hash, logData []byte),
+	addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte),
 ) error {
 	authorizedBridges := h.cronosKeeper.GetParams(ctx).CroBridgeContractAddresses
 	if !slices.ContainsFunc(authorizedBridges, func(addr string) bool {
@@ -81,15 +81,19 @@ func (h SendCroToIbcHandler) Handle(
 
 	unpacked, err := SendCroToIbcEvent.Inputs.Unpack(data)
 	if err != nil {
-		// log and ignore
-		h.cronosKeeper.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
-		return nil
+		return reportMalformedLog(
+			ctx, h.cronosKeeper, contract, SendCroToIbcEvent.ID,
+			"log signature matches but failed to decode: "+err.Error(), addLogToReceipt,
+		)
 	}
 
 	contractAddr := sdk.AccAddress(contract.Bytes())
 	sender := sdk.AccAddress(unpacked[0].(common.Address).Bytes())
 	recipient := unpacked[1].(string)
 	amount := sdkmath.NewIntFromBigInt(unpacked[2].(*big.Int))
+	if err := h.cronosKeeper.AuthorizeLogHandler(ctx, SendCroToIbcEventName, contract, amount); err != nil {
+		return err
+	}
 	evmDenom := h.cronosKeeper.GetEvmParams(ctx).EvmDenom
 	coins := sdk.NewCoins(sdk.NewCoin(evmDenom, amount))
 	// First, transfer IBC coin to user so that he will be the refunded address if transfer fails
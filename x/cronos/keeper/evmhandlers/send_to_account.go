@@ -16,7 +16,7 @@ import (
 
 var _ types.EvmLogHandler = SendToAccountHandler{}
 
-const SendToAccountEventName = "__CronosSendToAccount"
+const SendToAccountEventName = types.LogHandlerSendToAccount
 
 // SendToAccountEvent represent the signature of
 // `event __CronosSendToAccount(address recipient, uint256 amount)`
@@ -64,13 +64,14 @@ func (h SendToAccountHandler) Handle(
 	contract common.Address,
 	topics []common.Hash,
 	data []byte,
-	_ func(contractAddress common.Address, logSig common.Hash, logData []byte),
+	addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte),
 ) error {
 	unpacked, err := SendToAccountEvent.Inputs.Unpack(data)
 	if err != nil {
-		// log and ignore
-		h.cronosKeeper.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
-		return nil
+		return reportMalformedLog(
+			ctx, h.cronosKeeper, contract, SendToAccountEvent.ID,
+			"log signature matches but failed to decode: "+err.Error(), addLogToReceipt,
+		)
 	}
 
 	denom, found := h.cronosKeeper.GetDenomByContract(ctx, contract)
@@ -80,7 +81,11 @@ func (h SendToAccountHandler) Handle(
 
 	contractAddr := sdk.AccAddress(contract.Bytes())
 	recipient := sdk.AccAddress(unpacked[0].(common.Address).Bytes())
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(unpacked[1].(*big.Int))))
+	amount := sdkmath.NewIntFromBigInt(unpacked[1].(*big.Int))
+	if err := h.cronosKeeper.AuthorizeLogHandler(ctx, SendToAccountEventName, contract, amount); err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
 	err = h.bankKeeper.SendCoins(ctx, contractAddr, recipient, coins)
 	if err != nil {
 		return err
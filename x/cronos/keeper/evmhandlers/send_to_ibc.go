@@ -16,7 +16,7 @@ import (
 
 var _ types.EvmLogHandler = SendToIbcHandler{}
 
-const SendToIbcEventName = "__CronosSendToIbc"
+const SendToIbcEventName = types.LogHandlerSendToIbc
 
 // SendToIbcEvent represent the signature of
 // `event __CronosSendToIbc(address sender, string recipient, uint256 amount)`
@@ -69,13 +69,14 @@ func (h SendToIbcHandler) Handle(
 	contract common.Address,
 	topics []common.Hash,
 	data []byte,
-	_ func(contractAddress common.Address, logSig common.Hash, logData []byte),
+	addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte),
 ) error {
 	unpacked, err := SendToIbcEvent.Inputs.Unpack(data)
 	if err != nil {
-		// log and ignore
-		h.cronosKeeper.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
-		return nil
+		return reportMalformedLog(
+			ctx, h.cronosKeeper, contract, SendToIbcEvent.ID,
+			"log signature matches but failed to decode: "+err.Error(), addLogToReceipt,
+		)
 	}
 	sender := unpacked[0].(common.Address)
 	recipient := unpacked[1].(string)
@@ -103,6 +104,9 @@ func (h SendToIbcHandler) handle(
 	contractAddr := sdk.AccAddress(contract.Bytes())
 	sender := sdk.AccAddress(senderAddress.Bytes())
 	amount := sdkmath.NewIntFromBigInt(amountInt)
+	if err := h.cronosKeeper.AuthorizeLogHandler(ctx, SendToIbcEventName, contract, amount); err != nil {
+		return err
+	}
 	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
 	var err error
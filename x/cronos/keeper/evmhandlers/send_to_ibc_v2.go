@@ -72,22 +72,24 @@ func (h SendToIbcV2Handler) Handle(
 	contract common.Address,
 	topics []common.Hash,
 	data []byte,
-	_ func(contractAddress common.Address, logSig common.Hash, logData []byte),
+	addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte),
 ) error {
 	if len(topics) != 3 {
-		// log and ignore
-		h.cronosKeeper.Logger(ctx).Info("log signature matches but wrong number of indexed events")
 		for i, topic := range topics {
 			h.cronosKeeper.Logger(ctx).Debug(fmt.Sprintf("topic index: %d value: %s", i, topic.TerminalString()))
 		}
-		return nil
+		return reportMalformedLog(
+			ctx, h.cronosKeeper, contract, SendToIbcEventV2.ID,
+			fmt.Sprintf("log signature matches but wrong number of indexed events: %d", len(topics)), addLogToReceipt,
+		)
 	}
 
 	unpacked, err := SendToIbcEventV2.Inputs.Unpack(data)
 	if err != nil {
-		// log and ignore
-		h.cronosKeeper.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
-		return nil
+		return reportMalformedLog(
+			ctx, h.cronosKeeper, contract, SendToIbcEventV2.ID,
+			"log signature matches but failed to decode: "+err.Error(), addLogToReceipt,
+		)
 	}
 
 	// needs to crope the extra bytes in the topic by using BytesToAddress
@@ -201,11 +201,12 @@ func (suite *KeeperTestSuite) TestSendToIbcHandler() {
 			cronosKeeper := *cronosmodulekeeper.NewKeeper(
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
-				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetTKey(types.TStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.E2EEKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			handler := evmhandlers.NewSendToIbcHandler(suite.app.BankKeeper, cronosKeeper)
@@ -324,11 +325,12 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 			cronosKeeper := *cronosmodulekeeper.NewKeeper(
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
-				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetTKey(types.TStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.E2EEKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			handler := evmhandlers.NewSendToIbcV2Handler(suite.app.BankKeeper, cronosKeeper)
@@ -479,11 +481,12 @@ func (suite *KeeperTestSuite) TestSendCroToIbcHandler() {
 			cronosKeeper := *cronosmodulekeeper.NewKeeper(
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
-				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetTKey(types.TStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.E2EEKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			handler := evmhandlers.NewSendCroToIbcHandler(suite.app.BankKeeper, cronosKeeper)
@@ -498,3 +501,138 @@ func (suite *KeeperTestSuite) TestSendCroToIbcHandler() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestLogActionDispatcher() {
+	contract := common.BigToAddress(big.NewInt(0x101))
+	recipient := common.BigToAddress(big.NewInt(0x103))
+	action := types.LogAction{
+		Contract: contract.Hex(),
+		Event: `{"type":"event","name":"Payout","inputs":[` +
+			`{"name":"recipient","type":"address","indexed":true},{"name":"amount","type":"uint256"}]}`,
+		MsgTemplate: `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"$contract","to_address":"$recipient",` +
+			`"amount":[{"denom":"$denom","amount":"$amount"}]}`,
+	}
+	event, err := action.ParseEvent()
+	suite.Require().NoError(err)
+	topics := []common.Hash{event.ID, common.BytesToHash(recipient.Bytes())}
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(100))
+	suite.Require().NoError(err)
+	coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		postcheck func()
+		error     error
+	}{
+		{
+			"no action bound, log ignored",
+			func() {},
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, contract.Bytes(), denom)
+				suite.Require().Equal(coin, balance)
+			},
+			nil,
+		},
+		{
+			"success send to recipient",
+			func() {
+				suite.Require().NoError(suite.app.CronosKeeper.SetLogAction(suite.ctx, action))
+			},
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, contract.Bytes(), denom)
+				suite.Require().True(balance.IsZero())
+				balance = suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), denom)
+				suite.Require().Equal(coin, balance)
+			},
+			nil,
+		},
+		{
+			"message not signed by the contract, expect fail",
+			func() {
+				malicious := action
+				malicious.MsgTemplate = `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"$recipient",` +
+					`"to_address":"$contract","amount":[{"denom":"$denom","amount":"$amount"}]}`
+				suite.Require().NoError(suite.app.CronosKeeper.SetLogAction(suite.ctx, malicious))
+			},
+			func() {},
+			errors.New("log action message of contract 0x0000000000000000000000000000000000000101 must be signed by the contract"),
+		},
+		{
+			"contract denied by the log action policy, expect fail",
+			func() {
+				suite.Require().NoError(suite.app.CronosKeeper.SetLogAction(suite.ctx, action))
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.LogHandlerPolicies = []types.LogHandlerPolicy{
+					{EventName: types.LogHandlerLogAction, DeniedContracts: []string{contract.Hex()}},
+				}
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+			},
+			func() {},
+			errors.New("__CronosLogAction: contract 0x0000000000000000000000000000000000000101 is denied: contract is not authorized to trigger the log handler"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, denom, contract))
+			suite.Require().NoError(suite.MintCoins(contract.Bytes(), sdk.NewCoins(coin)))
+
+			dispatcher := evmhandlers.NewLogActionDispatcher(
+				suite.app.EncodingConfig().Codec, suite.app.MsgServiceRouter(), suite.app.CronosKeeper,
+			)
+			tc.malleate()
+			err := dispatcher.Dispatch(suite.ctx, contract, topics, data, func(contractAddress common.Address, logSig common.Hash, logData []byte) {})
+			if tc.error != nil {
+				suite.Require().EqualError(err, tc.error.Error())
+			} else {
+				suite.Require().NoError(err)
+				tc.postcheck()
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMalformedLogReport() {
+	contract := common.BigToAddress(big.NewInt(0x101))
+	topics := []common.Hash{evmhandlers.SendToAccountEvent.ID}
+
+	testCases := []struct {
+		msg    string
+		strict bool
+		error  error
+	}{
+		{"handler error log added to receipt", false, nil},
+		{"strict mode reverts the tx", true, types.ErrMalformedLog},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			params := suite.app.CronosKeeper.GetParams(suite.ctx)
+			params.StrictLogHandlers = tc.strict
+			suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+			var logs [][]byte
+			var logSigs []common.Hash
+			handler := evmhandlers.NewSendToAccountHandler(suite.app.BankKeeper, suite.app.CronosKeeper)
+			err := handler.Handle(suite.ctx, contract, topics, []byte{0x1}, func(contractAddress common.Address, logSig common.Hash, logData []byte) {
+				suite.Require().Equal(contract, contractAddress)
+				logSigs = append(logSigs, logSig)
+				logs = append(logs, logData)
+			})
+			if tc.error != nil {
+				suite.Require().ErrorIs(err, tc.error)
+				suite.Require().Empty(logs)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal([]common.Hash{evmhandlers.HandlerErrorEvent.ID}, logSigs)
+			unpacked, err := evmhandlers.HandlerErrorEvent.Inputs.Unpack(logs[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal([32]byte(evmhandlers.SendToAccountEvent.ID), unpacked[0].([32]byte))
+			suite.Require().Contains(unpacked[1].(string), "failed to decode")
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -68,7 +69,7 @@ func (k Keeper) ReplayBlock(goCtx context.Context, req *types.ReplayBlockRequest
 	ctx := sdk.UnwrapSDKContext(goCtx).
 		WithBlockHeight(req.BlockNumber).
 		WithBlockTime(req.BlockTime).
-		WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+		WithHeaderHash(common.HexToHash(req.BlockHash).Bytes())
 
 	// Per-message gas cap. A committed tx already fits within the block gas
 	// limit, so legitimate replay is unaffected.
@@ -103,8 +104,9 @@ func (k Keeper) ReplayBlock(goCtx context.Context, req *types.ReplayBlockRequest
 		cumulativeGas += gas
 	}
 
+	var traces [][]byte
 	// we assume the message executions are successful, they are filtered in json-rpc api
-	for _, msg := range req.Msgs {
+	for txIndex, msg := range req.Msgs {
 		// deduct fee
 		// populate the `From` field
 		if _, err := msg.GetSenderLegacy(ethtypes.LatestSignerForChainID(chainID)); err != nil {
@@ -128,6 +130,15 @@ func (k Keeper) ReplayBlock(goCtx context.Context, req *types.ReplayBlockRequest
 		}
 		k.accountKeeper.SetAccount(ctx, acc)
 
+		if req.TraceConfig != nil {
+			trace, err := k.traceReplayMsg(ctx, msg, ethCfg, baseFee, evmDenom, req.TraceConfig, txIndex)
+			if err != nil {
+				return nil, err
+			}
+			traces = append(traces, trace)
+			continue
+		}
+
 		rsp, err := k.evmKeeper.EthereumTx(ctx, msg)
 		if err != nil {
 			return nil, err
@@ -136,6 +147,7 @@ func (k Keeper) ReplayBlock(goCtx context.Context, req *types.ReplayBlockRequest
 	}
 	return &types.ReplayBlockResponse{
 		Responses: rsps,
+		Traces:    traces,
 	}, nil
 }
 
@@ -181,3 +193,130 @@ func (k Keeper) BlockList(goCtx context.Context, req *types.QueryBlockListReques
 		Blob: blob,
 	}, nil
 }
+
+// PendingRefundConversions returns the failed refund conversions waiting to be retried for an address
+func (k Keeper) PendingRefundConversions(goCtx context.Context, req *types.QueryPendingRefundConversionsRequest) (*types.QueryPendingRefundConversionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryPendingRefundConversionsResponse{
+		Conversions: k.GetPendingRefundConversionsByAddress(ctx, acc),
+	}, nil
+}
+
+// Dust returns the dust balances of an address
+func (k Keeper) Dust(goCtx context.Context, req *types.QueryDustRequest) (*types.QueryDustResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryDustResponse{
+		Dust: k.GetDustBalances(ctx, acc),
+	}, nil
+}
+
+// LogActions returns the native actions bound to evm logs
+func (k Keeper) LogActions(goCtx context.Context, req *types.QueryLogActionsRequest) (*types.QueryLogActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryLogActionsResponse{
+		Actions: k.GetAllLogActions(ctx),
+	}, nil
+}
+
+// SimulateBundle executes the evm calls in sequence on top of the block state with the state overrides,
+// the multistore version should be setup already in grpc query context.
+func (k Keeper) SimulateBundle(goCtx context.Context, req *types.SimulateBundleRequest) (*types.SimulateBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Calls) > types.MaxSimulateBundleCalls {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"too many calls in SimulateBundle request: %d (max %d)", len(req.Calls), types.MaxSimulateBundleCalls)
+	}
+	gasBudget := uint64(ReplayBlockGasCap * 2)
+	var cumulativeGas uint64
+	for _, call := range req.Calls {
+		gas := simulateCallGas(call)
+		if gas > ReplayBlockGasCap {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"call gas limit %d exceeds SimulateBundle cap %d", gas, ReplayBlockGasCap)
+		}
+		if gas > gasBudget-cumulativeGas {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"cumulative call gas exceeds SimulateBundle budget %d", gasBudget)
+		}
+		cumulativeGas += gas
+	}
+
+	var overrides types.StateOverride
+	if len(req.Overrides) > 0 {
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := overrides.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx).
+		WithBlockHeight(req.BlockNumber).
+		WithBlockTime(req.BlockTime).
+		WithHeaderHash(common.HexToHash(req.BlockHash).Bytes())
+
+	if err := k.applyStateOverride(ctx, overrides); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.SimulateCallResult, 0, len(req.Calls))
+	for _, call := range req.Calls {
+		result, err := k.simulateCall(ctx, call)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return &types.SimulateBundleResponse{
+		Results: results,
+	}, nil
+}
+
+// TransactionReceiptsByBlock implements the Query/TransactionReceiptsByBlock gRPC method, it's delegated to
+// the block querier of the node.
+func (k Keeper) TransactionReceiptsByBlock(goCtx context.Context, req *types.QueryTransactionReceiptsByBlockRequest) (*types.QueryTransactionReceiptsByBlockResponse, error) {
+	querier, err := k.getBlockQuerier()
+	if err != nil {
+		return nil, err
+	}
+	return querier.TransactionReceiptsByBlock(goCtx, req)
+}
+
+// ReplayBlockByHeight implements the Query/ReplayBlockByHeight gRPC method, it's delegated to the block
+// querier of the node.
+func (k Keeper) ReplayBlockByHeight(goCtx context.Context, req *types.QueryReplayBlockByHeightRequest) (*types.QueryReplayBlockByHeightResponse, error) {
+	querier, err := k.getBlockQuerier()
+	if err != nil {
+		return nil, err
+	}
+	return querier.ReplayBlockByHeight(goCtx, req)
+}
+
+// getBlockQuerier returns the registered block querier, the queries are unavailable without the node client,
+// e.g. in the simulations.
+func (k Keeper) getBlockQuerier() (types.BlockQuerier, error) {
+	if k.blockQuerier == nil || k.blockQuerier.querier == nil {
+		return nil, status.Error(codes.Unavailable, "block queries are not served by this node")
+	}
+	return k.blockQuerier.querier, nil
+}
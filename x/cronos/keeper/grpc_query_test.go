@@ -1,11 +1,20 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/big"
 
 	cronoskeeper "github.com/crypto-org-chain/cronos/x/cronos/keeper"
 	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // TestReplayBlockBounds exercises the DoS bounds on the public ReplayBlock
@@ -74,3 +83,138 @@ func (suite *KeeperTestSuite) TestReplayBlockBounds() {
 		})
 	}
 }
+
+// TestReplayBlockTraced checks the traced replay leaves the same state as the untraced one,
+// including the gas refund, so the later messages are traced against the state they executed on.
+func (suite *KeeperTestSuite) TestReplayBlockTraced() {
+	suite.SetupTest()
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sender := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	recipient := common.BigToAddress(big.NewInt(0x1000))
+	denom := suite.evmParam.EvmDenom
+	suite.Require().NoError(suite.MintCoins(sender.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1e18)))))
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	gasPrice := big.NewInt(1)
+	if baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, suite.evmParam.ChainConfig.EthereumConfig(chainID)); baseFee != nil {
+		gasPrice = baseFee
+	}
+	msgs := make([]*evmtypes.MsgEthereumTx, 2)
+	for i := range msgs {
+		msgs[i] = evmtypes.NewTx(chainID, uint64(i), &recipient, big.NewInt(100), 50000, gasPrice, nil, nil, nil, nil)
+		msgs[i].From = sender.Bytes()
+		suite.Require().NoError(msgs[i].Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+	}
+
+	replay := func(traceConfig *evmtypes.TraceConfig) (sdkmath.Int, sdkmath.Int, uint64) {
+		ctx, _ := suite.ctx.CacheContext()
+		rsp, err := suite.app.CronosKeeper.ReplayBlock(ctx, &types.ReplayBlockRequest{
+			Msgs:        msgs,
+			BlockNumber: ctx.BlockHeight(),
+			BlockTime:   ctx.BlockTime(),
+			TraceConfig: traceConfig,
+		})
+		suite.Require().NoError(err)
+		if traceConfig != nil {
+			suite.Require().Len(rsp.Traces, len(msgs))
+		}
+		return suite.app.BankKeeper.GetBalance(ctx, sender.Bytes(), denom).Amount,
+			suite.app.BankKeeper.GetBalance(ctx, recipient.Bytes(), denom).Amount,
+			suite.app.EvmKeeper.GetNonce(ctx, sender)
+	}
+
+	senderBalance, recipientBalance, nonce := replay(nil)
+	// only the gas used is charged
+	fee := sdkmath.NewIntFromBigInt(gasPrice).MulRaw(2 * 21000)
+	suite.Require().Equal(sdkmath.NewInt(1e18).Sub(fee).SubRaw(200), senderBalance)
+	suite.Require().Equal(sdkmath.NewInt(200), recipientBalance)
+	suite.Require().Equal(uint64(2), nonce)
+
+	tracedSenderBalance, tracedRecipientBalance, tracedNonce := replay(&evmtypes.TraceConfig{})
+	suite.Require().Equal(senderBalance, tracedSenderBalance)
+	suite.Require().Equal(recipientBalance, tracedRecipientBalance)
+	suite.Require().Equal(nonce, tracedNonce)
+}
+
+func (suite *KeeperTestSuite) TestSimulateBundle() {
+	recipient := common.BigToAddress(big.NewInt(0x1000))
+	transfer := types.SimulateCall{
+		From:  suite.address.Hex(),
+		To:    recipient.Hex(),
+		Value: sdkmath.NewInt(100),
+		Gas:   21000,
+	}
+
+	testCases := []struct {
+		name      string
+		calls     []types.SimulateCall
+		overrides string
+		errMatch  string
+		expPass   func(rsp *types.SimulateBundleResponse)
+	}{
+		{
+			name:     "too many calls",
+			calls:    make([]types.SimulateCall, types.MaxSimulateBundleCalls+1),
+			errMatch: "too many calls",
+		},
+		{
+			name:     "call gas cap",
+			calls:    []types.SimulateCall{{From: suite.address.Hex(), Gas: cronoskeeper.ReplayBlockGasCap + 1}},
+			errMatch: "exceeds SimulateBundle cap",
+		},
+		{
+			name:     "invalid from address",
+			calls:    []types.SimulateCall{{From: "invalid", Value: sdkmath.ZeroInt()}},
+			errMatch: "invalid from address",
+		},
+		{
+			name:      "conflicting overrides",
+			calls:     []types.SimulateCall{transfer},
+			overrides: fmt.Sprintf(`{"%s":{"state":{},"stateDiff":{}}}`, recipient.Hex()),
+			errMatch:  "both 'state' and 'stateDiff'",
+		},
+		{
+			name:      "sequential transfers with balance override",
+			calls:     []types.SimulateCall{transfer, transfer},
+			overrides: fmt.Sprintf(`{"%s":{"balance":"0x2710"}}`, suite.address.Hex()),
+			expPass: func(rsp *types.SimulateBundleResponse) {
+				suite.Require().Len(rsp.Results, 2)
+				for _, res := range rsp.Results {
+					suite.Require().Empty(res.VmError)
+					suite.Require().Equal(uint64(21000), res.GasUsed)
+				}
+			},
+		},
+		{
+			name:      "insufficient balance after the first transfer",
+			calls:     []types.SimulateCall{transfer, transfer},
+			overrides: fmt.Sprintf(`{"%s":{"balance":"0x64"}}`, suite.address.Hex()),
+			expPass: func(rsp *types.SimulateBundleResponse) {
+				suite.Require().Len(rsp.Results, 2)
+				suite.Require().Empty(rsp.Results[0].VmError)
+				suite.Require().NotEmpty(rsp.Results[1].VmError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			req := &types.SimulateBundleRequest{
+				Calls:       tc.calls,
+				BlockNumber: ctx.BlockHeight(),
+				BlockTime:   ctx.BlockTime(),
+				Overrides:   []byte(tc.overrides),
+			}
+			rsp, err := suite.app.CronosKeeper.SimulateBundle(ctx, req)
+			if tc.errMatch != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMatch)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.expPass(rsp)
+		})
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,42 +26,37 @@ func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sd
 	params := k.GetParams(ctx)
 	evmParams := k.GetEvmParams(ctx)
 	for _, c := range coins {
-		switch c.Denom {
-		case params.IbcCroDenom:
-			if params.IbcCroDenom == "" {
-				return errorsmod.Wrap(types.ErrIbcCroDenomEmpty, "ibc is disabled")
-			}
-
-			amount, err := c.Amount.SafeMul(sdkmath.NewIntFromBigInt(types.TenPowTen))
+		scale, found, err := k.getScaleByDenom(ctx, params, evmParams.EvmDenom, c.Denom)
+		if err != nil {
+			return err
+		}
+		if !found {
+			err := k.ConvertCoinFromNativeToCRC21(ctx, common.BytesToAddress(acc.Bytes()), c, params.EnableAutoDeployment)
 			if err != nil {
-				return errorsmod.Wrapf(err, "converting %s to 18 decimals", c)
-			}
-			amount18dec := sdk.NewCoin(evmParams.EvmDenom, amount)
-
-			// Send ibc tokens to escrow address
-			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, sdk.NewCoins(c)); err != nil {
 				return err
 			}
+			continue
+		}
 
-			// Mint new evm tokens
-			if err := k.bankKeeper.MintCoins(
-				ctx, types.ModuleName, sdk.NewCoins(amount18dec),
-			); err != nil {
-				return err
-			}
+		amount, err := c.Amount.SafeMul(scale.factor)
+		if err != nil {
+			return errorsmod.Wrapf(err, "converting %s to %s", c, scale.targetDenom)
+		}
+		scaledCoins := sdk.NewCoins(sdk.NewCoin(scale.targetDenom, amount))
 
-			// Send evm tokens to receiver
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, types.ModuleName, acc, sdk.NewCoins(amount18dec),
-			); err != nil {
-				return err
-			}
+		// Send ibc tokens to escrow address
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, sdk.NewCoins(c)); err != nil {
+			return err
+		}
 
-		default:
-			err := k.ConvertCoinFromNativeToCRC21(ctx, common.BytesToAddress(acc.Bytes()), c, params.EnableAutoDeployment)
-			if err != nil {
-				return err
-			}
+		// Mint new scaled tokens
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, scaledCoins); err != nil {
+			return err
+		}
+
+		// Send scaled tokens to receiver
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, acc, scaledCoins); err != nil {
+			return err
 		}
 	}
 	defer func() {
@@ -93,56 +87,27 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 	evmParams := k.GetEvmParams(ctx)
 
 	for _, c := range coins {
-		switch c.Denom {
-		case evmParams.EvmDenom:
-			// Compute the remainder, we won't transfer anything lower than 10^10
-			amount8decRem := c.Amount.Mod(sdkmath.NewIntFromBigInt(types.TenPowTen))
-			amountToBurn := c.Amount.Sub(amount8decRem)
-			if amountToBurn.IsZero() {
-				// Amount too small
-				continue
-			}
-			coins := sdk.NewCoins(sdk.NewCoin(evmParams.EvmDenom, amountToBurn))
-
-			// Send evm tokens to escrow address
-			err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, coins)
-			if err != nil {
-				return err
-			}
-			// Burns the evm tokens
-			if err := k.bankKeeper.BurnCoins(
-				ctx, types.ModuleName, coins); err != nil {
-				return err
-			}
-
-			// Transfer ibc tokens back to the user
-			// We divide by 10^10 to come back to an 8decimals token
-			amount8dec := c.Amount.Quo(sdkmath.NewIntFromBigInt(types.TenPowTen))
-			ibcCoin := sdk.NewCoin(params.IbcCroDenom, amount8dec)
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, types.ModuleName, acc, sdk.NewCoins(ibcCoin),
-			); err != nil {
-				return err
-			}
-
-			// No need to specify the channelId because it's not a source token
-			err = k.ibcSendTransfer(ctx, acc, destination, ibcCoin, "")
-			if err != nil {
+		scale, found, err := k.getScaleByTarget(ctx, params, evmParams.EvmDenom, c.Denom)
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := k.ibcTransferScaledCoin(ctx, acc, destination, c, scale, channelId); err != nil {
 				return err
 			}
+			continue
+		}
 
-		default:
-			if !types.IsValidIBCDenom(c.Denom) && !types.IsValidCronosDenom(c.Denom) {
-				return fmt.Errorf("the coin %s is neither an ibc voucher or a cronos token", c.Denom)
-			}
-			_, found := k.GetContractByDenom(ctx, c.Denom)
-			if !found {
-				return fmt.Errorf("coin %s is not supported", c.Denom)
-			}
-			err = k.ibcSendTransfer(ctx, acc, destination, c, channelId)
-			if err != nil {
-				return err
-			}
+		if !types.IsValidIBCDenom(c.Denom) && !types.IsValidCronosDenom(c.Denom) {
+			return fmt.Errorf("the coin %s is neither an ibc voucher or a cronos token", c.Denom)
+		}
+		_, found = k.GetContractByDenom(ctx, c.Denom)
+		if !found {
+			return fmt.Errorf("coin %s is not supported", c.Denom)
+		}
+		err = k.ibcSendTransfer(ctx, acc, destination, c, channelId)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -160,6 +125,49 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 	return nil
 }
 
+// ibcTransferScaledCoin converts the scaled coin back to its source denom and transfers it through IBC,
+// the remainder lower than the scaling factor is kept as dust by the module account and is added to the
+// next transfer of the sender.
+func (k Keeper) ibcTransferScaledCoin(
+	ctx sdk.Context, acc sdk.AccAddress, destination string, c sdk.Coin, scale denomScale, channelId string,
+) error {
+	dust := k.GetDustBalance(ctx, acc, c.Denom)
+	total := c.Amount.Add(dust)
+	remainder := total.Mod(scale.factor)
+	amountToBurn := total.Sub(remainder)
+	if amountToBurn.IsZero() {
+		// Amount too small
+		return nil
+	}
+
+	// Send scaled tokens to escrow address
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, sdk.NewCoins(c)); err != nil {
+		return err
+	}
+	// Burns the scaled tokens, the remainder is kept as dust
+	if err := k.bankKeeper.BurnCoins(
+		ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(c.Denom, amountToBurn))); err != nil {
+		return err
+	}
+	if err := k.SetDustBalance(ctx, types.DustBalance{
+		Address: acc.String(),
+		Amount:  sdk.NewCoin(c.Denom, remainder),
+	}); err != nil {
+		return err
+	}
+
+	// Transfer source tokens back to the user
+	sourceCoin := sdk.NewCoin(scale.denom, amountToBurn.Quo(scale.factor))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, acc, sdk.NewCoins(sourceCoin),
+	); err != nil {
+		return err
+	}
+
+	// The channelId is ignored if the source tokens are vouchers
+	return k.ibcSendTransfer(ctx, acc, destination, sourceCoin, channelId)
+}
+
 func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destination string, coin sdk.Coin, channelId string) error {
 	if types.IsSourceCoin(coin.Denom) {
 		if !channeltypes.IsValidChannelID(channelId) {
@@ -327,11 +327,12 @@ func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 			cronosKeeper := *cronosmodulekeeper.NewKeeper(
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
-				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetTKey(types.TStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.E2EEKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			suite.app.CronosKeeper = cronosKeeper
@@ -29,7 +29,8 @@ type (
 	Keeper struct {
 		cdc      codec.Codec
 		storeKey storetypes.StoreKey
-		memKey   storetypes.StoreKey
+		// transient store of the per block state
+		transientKey storetypes.StoreKey
 
 		// update balance and accounting operations with coins
 		bankKeeper types.BankKeeper
@@ -39,11 +40,16 @@ type (
 		evmKeeper types.EvmKeeper
 		// account keeper
 		accountKeeper types.AccountKeeper
+		// e2ee keeper to verify the block list coverage
+		e2eeKeeper types.E2EEKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
 
+		// shared by the keeper copies, the block querier is only set once the node client is available.
+		blockQuerier *blockQuerierRef
+
 		// this line is used by starport scaffolding # ibc/keeper/attribute
 	}
 )
@@ -53,11 +59,12 @@ var _ ibccallbacktypes.ContractKeeper = Keeper{}
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey,
-	memKey storetypes.StoreKey,
+	transientKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	transferKeeper types.TransferKeeper,
 	evmKeeper types.EvmKeeper,
 	accountKeeper types.AccountKeeper,
+	e2eeKeeper types.E2EEKeeper,
 	authority string,
 	// this line is used by starport scaffolding # ibc/keeper/parameter
 ) *Keeper {
@@ -68,16 +75,29 @@ func NewKeeper(
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
-		memKey:         memKey,
+		transientKey:   transientKey,
 		bankKeeper:     bankKeeper,
 		transferKeeper: transferKeeper,
 		evmKeeper:      evmKeeper,
 		accountKeeper:  accountKeeper,
+		e2eeKeeper:     e2eeKeeper,
 		authority:      authority,
+		blockQuerier:   &blockQuerierRef{},
 		// this line is used by starport scaffolding # ibc/keeper/return
 	}
 }
 
+// blockQuerierRef holds the block querier registered by the node.
+type blockQuerierRef struct {
+	querier types.BlockQuerier
+}
+
+// SetBlockQuerier registers the node-backed server of the block queries, it must be called before the gRPC
+// server starts serving.
+func (k Keeper) SetBlockQuerier(querier types.BlockQuerier) {
+	k.blockQuerier.querier = querier
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -212,6 +232,7 @@ func (k Keeper) SetExternalContractForDenom(ctx sdk.Context, denom string, addre
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.DenomToExternalContractKey(denom), address.Bytes())
 	store.Set(types.ContractToDenomKey(address.Bytes()), []byte(denom))
+	ctx.EventManager().EmitEvent(types.NewTokenMappingUpdatedEvent(denom, address.Hex(), false))
 	return nil
 }
 
@@ -251,6 +272,7 @@ func (k Keeper) DeleteExternalContractForDenom(ctx sdk.Context, denom string) bo
 	}
 	store.Delete(types.DenomToExternalContractKey(denom))
 	deleteReverseIfOwned(store, contract, denom)
+	ctx.EventManager().EmitEvent(types.NewTokenMappingUpdatedEvent(denom, "", false))
 	if auto, found := k.getAutoContractByDenom(ctx, denom); found {
 		bz := store.Get(types.ContractToDenomKey(auto.Bytes()))
 		if len(bz) == 0 {
@@ -283,6 +305,7 @@ func (k Keeper) SetAutoContractForDenom(ctx sdk.Context, denom string, address c
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.DenomToAutoContractKey(denom), address.Bytes())
 	store.Set(types.ContractToDenomKey(address.Bytes()), []byte(denom))
+	ctx.EventManager().EmitEvent(types.NewTokenMappingUpdatedEvent(denom, address.Hex(), true))
 	return nil
 }
 
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetLogAction binds the logs of the event emitted by the contract to the native message of the action
+func (k Keeper) SetLogAction(ctx sdk.Context, action types.LogAction) error {
+	if err := action.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrLogActionInvalid, err.Error())
+	}
+	event, err := action.ParseEvent()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrLogActionInvalid, err.Error())
+	}
+	// normalize the address so it's exported the same way
+	action.Contract = common.HexToAddress(action.Contract).Hex()
+	ctx.KVStore(k.storeKey).Set(types.LogActionKey(common.HexToAddress(action.Contract), event.ID), k.cdc.MustMarshal(&action))
+	return nil
+}
+
+// GetLogAction returns the action bound to the event emitted by the contract
+func (k Keeper) GetLogAction(ctx sdk.Context, contract common.Address, eventID common.Hash) (types.LogAction, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LogActionKey(contract, eventID))
+	if len(bz) == 0 {
+		return types.LogAction{}, false
+	}
+	var action types.LogAction
+	k.cdc.MustUnmarshal(bz, &action)
+	return action, true
+}
+
+// DeleteLogAction removes the action bound to the event emitted by the contract, returns if it existed
+func (k Keeper) DeleteLogAction(ctx sdk.Context, contract common.Address, eventID common.Hash) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := types.LogActionKey(contract, eventID)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	return true
+}
+
+// GetAllLogActions returns all the actions bound to evm logs
+func (k Keeper) GetAllLogActions(ctx sdk.Context) (out []types.LogAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLogAction)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var action types.LogAction
+		k.cdc.MustUnmarshal(iter.Value(), &action)
+		out = append(out, action)
+	}
+	return out
+}
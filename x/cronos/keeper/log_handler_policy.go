@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"slices"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthorizeLogHandler checks the contract is allowed to trigger the log handler of the event by the policy
+// in params, and that the amount handled for the contract in the current block stays within the limit.
+// The rejections are counted in telemetry.
+func (k Keeper) AuthorizeLogHandler(ctx sdk.Context, eventName string, contract common.Address, amount sdkmath.Int) error {
+	params := k.GetParams(ctx)
+	idx := slices.IndexFunc(params.LogHandlerPolicies, func(policy types.LogHandlerPolicy) bool {
+		return policy.EventName == eventName
+	})
+	if idx < 0 {
+		return nil
+	}
+	policy := params.LogHandlerPolicies[idx]
+
+	matchContract := func(addr string) bool {
+		return common.HexToAddress(addr) == contract
+	}
+	if slices.ContainsFunc(policy.DeniedContracts, matchContract) {
+		return k.rejectLogHandler(eventName, "denied", "contract %s is denied", contract)
+	}
+	if len(policy.AllowedContracts) > 0 && !slices.ContainsFunc(policy.AllowedContracts, matchContract) {
+		return k.rejectLogHandler(eventName, "not_allowed", "contract %s is not allowed", contract)
+	}
+
+	if policy.MaxAmountPerBlock.IsNil() || policy.MaxAmountPerBlock.IsZero() {
+		return nil
+	}
+	total := k.getLogHandlerUsage(ctx, eventName, contract).Add(amount)
+	if total.GT(policy.MaxAmountPerBlock) {
+		return k.rejectLogHandler(
+			eventName, "limit_exceeded",
+			"amount %s of contract %s exceeds the limit %s per block", total, contract, policy.MaxAmountPerBlock,
+		)
+	}
+	return k.setLogHandlerUsage(ctx, eventName, contract, total)
+}
+
+func (k Keeper) rejectLogHandler(eventName, reason, format string, args ...interface{}) error {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "log_handler", "rejected"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("event", eventName),
+			telemetry.NewLabel("reason", reason),
+		},
+	)
+	return errorsmod.Wrapf(types.ErrLogHandlerUnauthorized, "%s: "+format, append([]interface{}{eventName}, args...)...)
+}
+
+// getLogHandlerUsage returns the amount handled by the log handler for the contract in the current block,
+// it's kept in the transient store which is discarded at the end of the block.
+func (k Keeper) getLogHandlerUsage(ctx sdk.Context, eventName string, contract common.Address) sdkmath.Int {
+	bz := ctx.TransientStore(k.transientKey).Get(types.LogHandlerUsageKey(eventName, contract))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return sdkmath.ZeroInt()
+	}
+	return amount
+}
+
+func (k Keeper) setLogHandlerUsage(ctx sdk.Context, eventName string, contract common.Address, amount sdkmath.Int) error {
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.TransientStore(k.transientKey).Set(types.LogHandlerUsageKey(eventName, contract), bz)
+	return nil
+}
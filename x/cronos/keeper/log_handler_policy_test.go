@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func (suite *KeeperTestSuite) TestAuthorizeLogHandler() {
+	const eventName = "__CronosSendToIbc"
+	contract := common.BigToAddress(big.NewInt(0x101))
+	other := common.BigToAddress(big.NewInt(0x102))
+
+	testCases := []struct {
+		name     string
+		policy   types.LogHandlerPolicy
+		malleate func()
+		amount   sdkmath.Int
+		expPass  bool
+	}{
+		{
+			"no policy for the handler",
+			types.LogHandlerPolicy{EventName: "__CronosSendToAccount", DeniedContracts: []string{contract.Hex()}},
+			func() {},
+			sdkmath.NewInt(100),
+			true,
+		},
+		{
+			"denied contract",
+			types.LogHandlerPolicy{
+				EventName:        eventName,
+				AllowedContracts: []string{contract.Hex()},
+				DeniedContracts:  []string{contract.Hex()},
+			},
+			func() {},
+			sdkmath.NewInt(100),
+			false,
+		},
+		{
+			"contract not in allowlist",
+			types.LogHandlerPolicy{EventName: eventName, AllowedContracts: []string{other.Hex()}},
+			func() {},
+			sdkmath.NewInt(100),
+			false,
+		},
+		{
+			"within the limit",
+			types.LogHandlerPolicy{EventName: eventName, MaxAmountPerBlock: sdkmath.NewInt(100)},
+			func() {},
+			sdkmath.NewInt(100),
+			true,
+		},
+		{
+			"limit exceeded in the same block",
+			types.LogHandlerPolicy{EventName: eventName, MaxAmountPerBlock: sdkmath.NewInt(100)},
+			func() {
+				err := suite.app.CronosKeeper.AuthorizeLogHandler(suite.ctx, eventName, contract, sdkmath.NewInt(60))
+				suite.Require().NoError(err)
+			},
+			sdkmath.NewInt(50),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.app.CronosKeeper.GetParams(suite.ctx)
+			params.LogHandlerPolicies = []types.LogHandlerPolicy{tc.policy}
+			suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+			tc.malleate()
+			err := suite.app.CronosKeeper.AuthorizeLogHandler(suite.ctx, eventName, contract, tc.amount)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrLogHandlerUnauthorized)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLogHandlerUsageIsTransient() {
+	const eventName = "__CronosSendToIbc"
+	contract := common.BigToAddress(big.NewInt(0x101))
+	suite.SetupTest()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.LogHandlerPolicies = []types.LogHandlerPolicy{{EventName: eventName, MaxAmountPerBlock: sdkmath.NewInt(100)}}
+	suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+	suite.Require().NoError(suite.app.CronosKeeper.AuthorizeLogHandler(suite.ctx, eventName, contract, sdkmath.NewInt(60)))
+
+	// the usage is only kept for the block, it's never written to the persistent store
+	key := types.LogHandlerUsageKey(eventName, contract)
+	suite.Require().True(suite.ctx.TransientStore(suite.app.GetTKey(types.TStoreKey)).Has(key))
+	suite.Require().False(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Has(key))
+}
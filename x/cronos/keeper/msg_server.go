@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"cosmossdk.io/errors"
 
@@ -124,6 +128,110 @@ func (k msgServer) StoreBlockList(goCtx context.Context, msg *types.MsgStoreBloc
 	if admin != msg.From {
 		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
 	}
+	if msg.VerifyCoverage {
+		if err := k.verifyBlockListCoverage(ctx, msg.Blob); err != nil {
+			return nil, err
+		}
+	}
 	ctx.KVStore(k.storeKey).Set(types.KeyPrefixBlockList, msg.Blob)
+	ctx.EventManager().EmitEvent(types.NewBlockListUpdatedEvent(msg.From))
 	return &types.MsgStoreBlockListResponse{}, nil
 }
+
+// verifyBlockListCoverage checks every bonded validator has a valid encryption key and the blob has an age stanza
+// for each of them, the validators failing to decrypt the block list would diverge in ProcessProposal. The ssh
+// stanzas are matched to the registered ssh keys by their tag, the other stanzas don't reveal their recipients, so
+// the blob encrypted to other native keys is only detected by the number of stanzas.
+func (k msgServer) verifyBlockListCoverage(ctx sdk.Context, blob []byte) error {
+	coverage, err := k.e2eeKeeper.ValidatorsCoverage(ctx, &e2eetypes.ValidatorsCoverageRequest{})
+	if err != nil {
+		return err
+	}
+	if len(coverage.Uncovered) > 0 {
+		uncovered := make([]string, len(coverage.Uncovered))
+		for i, val := range coverage.Uncovered {
+			uncovered[i] = fmt.Sprintf("%s (%s)", val.OperatorAddress, val.Reason)
+		}
+		return errors.Wrapf(types.ErrBlockListUncovered, "validators without valid encryption key: %s", strings.Join(uncovered, ", "))
+	}
+	stanzas, err := e2eetypes.RecipientStanzas(blob)
+	if err != nil {
+		return err
+	}
+
+	sshTags := make(map[string]struct{})
+	native := 0
+	for _, stanza := range stanzas {
+		if tag, ok := e2eetypes.StanzaSSHTag(stanza); ok {
+			sshTags[tag] = struct{}{}
+		} else {
+			native++
+		}
+	}
+	var missing []string
+	validators := 0
+	for _, val := range coverage.Covered {
+		if val.KeyType != e2eetypes.KeyTypeSSH {
+			validators++
+			continue
+		}
+		tag, err := e2eetypes.SSHStanzaTag(val.Key)
+		if err != nil {
+			return err
+		}
+		if _, ok := sshTags[tag]; !ok {
+			missing = append(missing, val.OperatorAddress)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Wrapf(types.ErrBlockListUncovered, "no stanza for the ssh keys of the validators: %s", strings.Join(missing, ", "))
+	}
+	if native < validators {
+		return errors.Wrapf(types.ErrBlockListUncovered, "%d native recipient stanzas for %d bonded validators", native, validators)
+	}
+	return nil
+}
+
+// ClaimDust implements the grpc method
+func (k msgServer) ClaimDust(goCtx context.Context, msg *types.MsgClaimDust) (*types.MsgClaimDustResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := k.Keeper.ClaimDust(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewDustClaimedEvent(msg.Address, coins))
+	return &types.MsgClaimDustResponse{Amount: coins}, nil
+}
+
+// SetLogAction implements the grpc method
+func (k msgServer) SetLogAction(goCtx context.Context, msg *types.MsgSetLogAction) (*types.MsgSetLogActionResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetLogAction(ctx, msg.Action); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLogActionResponse{}, nil
+}
+
+// DeleteLogAction implements the grpc method
+func (k msgServer) DeleteLogAction(goCtx context.Context, msg *types.MsgDeleteLogAction) (*types.MsgDeleteLogActionResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.Keeper.DeleteLogAction(ctx, common.HexToAddress(msg.Contract), common.HexToHash(msg.EventId)) {
+		return nil, errors.Wrapf(types.ErrLogActionInvalid, "no log action for %s %s", msg.Contract, msg.EventId)
+	}
+
+	return &types.MsgDeleteLogActionResponse{}, nil
+}
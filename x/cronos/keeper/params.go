@@ -28,6 +28,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
 	}
+	if err := params.ValidateDenomScalingTargets(k.GetEvmParams(ctx).EvmDenom); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&params)
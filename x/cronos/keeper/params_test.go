@@ -48,11 +48,12 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 			cronosKeeper := *cronosmodulekeeper.NewKeeper(
 				suite.app.EncodingConfig().Codec,
 				suite.app.GetKey(types.StoreKey),
-				suite.app.GetKey(types.MemStoreKey),
+				suite.app.GetTKey(types.TStoreKey),
 				suite.app.BankKeeper,
 				keepertest.IbcKeeperMock{},
 				suite.app.EvmKeeper,
 				suite.app.AccountKeeper,
+				suite.app.E2EEKeeper,
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			)
 			suite.app.CronosKeeper = cronosKeeper
@@ -67,3 +68,13 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetParamsRejectsEvmDenomScaling() {
+	suite.SetupTest()
+	params := suite.app.CronosKeeper.GetParams(suite.ctx)
+	params.DenomScalings = []types.DenomScaling{{Denom: CorrectIbcDenom, TargetDenom: suite.evmParam.EvmDenom}}
+	suite.Require().Error(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+
+	params.DenomScalings = []types.DenomScaling{{Denom: params.IbcCroDenom, TargetDenom: suite.evmParam.EvmDenom}}
+	suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+}
@@ -0,0 +1,171 @@
+package precompiles
+
+import (
+	"errors"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/events/bindings/cosmos/precompile/e2ee"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	RegisterKeyMethodName = "registerKey"
+	KeyOfMethodName       = "keyOf"
+	KeysOfMethodName      = "keysOf"
+
+	// KeysOfGasPerAddress is the gas of looking up the key of each address in keysOf.
+	KeysOfGasPerAddress = 5000
+)
+
+var (
+	e2eeABI                 abi.ABI
+	e2eeContractAddress     = common.BytesToAddress([]byte{103})
+	e2eeMethodNamesByID     = map[[4]byte]string{}
+	e2eeGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := e2eeABI.UnmarshalJSON([]byte(e2ee.E2EEModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range e2eeABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], e2eeABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case RegisterKeyMethodName:
+			e2eeGasRequiredByMethod[methodID] = 50000
+		case KeyOfMethodName, KeysOfMethodName:
+			e2eeGasRequiredByMethod[methodID] = 10000
+		default:
+			e2eeGasRequiredByMethod[methodID] = 0
+		}
+		e2eeMethodNamesByID[methodID] = methodName
+	}
+}
+
+type E2EEContract struct {
+	BaseContract
+
+	e2eeKeeper  types.E2EEKeyKeeper
+	kvGasConfig storetypes.GasConfig
+	enabled     bool
+}
+
+// NewE2EEContract creates the precompiled contract to register and look up the e2ee encryption keys, the keys are
+// registered to the caller of the contract. Before its activation the contract is disabled, it costs nothing and
+// returns nothing like the empty account the address was, so the replay of the earlier blocks is unchanged.
+func NewE2EEContract(e2eeKeeper types.E2EEKeyKeeper, kvGasConfig storetypes.GasConfig, enabled bool) vm.PrecompiledContract {
+	return &E2EEContract{
+		BaseContract: NewBaseContract(e2eeContractAddress),
+		e2eeKeeper:   e2eeKeeper,
+		kvGasConfig:  kvGasConfig,
+		enabled:      enabled,
+	}
+}
+
+func (ec *E2EEContract) Address() common.Address {
+	return e2eeContractAddress
+}
+
+func (ec *E2EEContract) Name() string {
+	return "e2ee"
+}
+
+// RequiredGas calculates the contract gas use, keysOf is charged for each address looked up.
+func (ec *E2EEContract) RequiredGas(input []byte) uint64 {
+	if !ec.enabled {
+		return 0
+	}
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * ec.kvGasConfig.WriteCostPerByte
+	if len(input) < 4 {
+		return baseCost
+	}
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	requiredGas, ok := e2eeGasRequiredByMethod[methodID]
+	if !ok {
+		return baseCost
+	}
+	if e2eeMethodNamesByID[methodID] == KeysOfMethodName && len(input) > 4+64 {
+		// the abi encoded address array is the offset and the length followed by a word for each address.
+		requiredGas += uint64(len(input)-4-64) / 32 * KeysOfGasPerAddress
+	}
+	return requiredGas + baseCost
+}
+
+func (ec *E2EEContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if !ec.enabled {
+		return nil, nil
+	}
+	// parse input
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
+	methodID := contract.Input[:4]
+	method, err := e2eeABI.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+	stateDB := evm.StateDB.(ExtStateDB)
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	switch method.Name {
+	case RegisterKeyMethodName:
+		if readonly {
+			return nil, errors.New("the method is not readonly")
+		}
+		msg := &e2eetypes.MsgRegisterEncryptionKey{
+			Address: sdk.AccAddress(contract.Caller().Bytes()).String(),
+			Key:     args[0].(string),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if err := stateDB.ExecuteNativeAction(ec.Address(), nil, func(ctx sdk.Context) error {
+			_, err := ec.e2eeKeeper.RegisterEncryptionKey(ctx, msg)
+			return err
+		}); err != nil {
+			return nil, errorsmod.Wrap(err, "fail to register encryption key in precompiled contract")
+		}
+		return method.Outputs.Pack(true)
+	case KeyOfMethodName:
+		keys, err := ec.keys(stateDB.Context(), []common.Address{args[0].(common.Address)})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(keys[0])
+	case KeysOfMethodName:
+		keys, err := ec.keys(stateDB.Context(), args[0].([]common.Address))
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(keys)
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
+
+// keys looks up the keys valid at the current block height through the Keys query, so the batch is capped by its
+// MaxKeysAddresses too, the addresses without a key have an empty key.
+func (ec *E2EEContract) keys(ctx sdk.Context, addrs []common.Address) ([]string, error) {
+	req := &e2eetypes.KeysRequest{Addresses: make([]string, len(addrs))}
+	for i, addr := range addrs {
+		req.Addresses[i] = sdk.AccAddress(addr.Bytes()).String()
+	}
+	rsp, err := ec.e2eeKeeper.Keys(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Keys, nil
+}
@@ -0,0 +1,167 @@
+package precompiles
+
+import (
+	"context"
+	"testing"
+
+	"filippo.io/age"
+	e2eekeeper "github.com/crypto-org-chain/cronos/x/e2ee/keeper"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type mockStakingKeeper struct{}
+
+func (mockStakingKeeper) GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error) {
+	return nil, nil
+}
+
+type mockBankKeeper struct{}
+
+func (mockBankKeeper) SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+// mockStateDB runs the native actions on the context directly.
+type mockStateDB struct {
+	vm.StateDB
+	ctx sdk.Context
+}
+
+func (db *mockStateDB) ExecuteNativeAction(_ common.Address, _ statedb.EventConverter, action func(ctx sdk.Context) error) error {
+	return action(db.ctx)
+}
+
+func (db *mockStateDB) Context() sdk.Context {
+	return db.ctx
+}
+
+func setupE2EEKeeper(t *testing.T) (e2eekeeper.Keeper, *vm.EVM) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(e2eetypes.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(10)
+	codec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	k := e2eekeeper.NewKeeper(
+		moduletestutil.MakeTestEncodingConfig().Codec, key, codec, mockStakingKeeper{}, mockBankKeeper{}, "authority",
+	)
+	require.NoError(t, k.SetParams(ctx, e2eetypes.DefaultParams()))
+	return k, &vm.EVM{StateDB: &mockStateDB{ctx: ctx}}
+}
+
+func runE2EE(
+	t *testing.T, ec vm.PrecompiledContract, evm *vm.EVM, caller common.Address, readonly bool, method string, args ...interface{},
+) ([]interface{}, error) {
+	t.Helper()
+
+	input, err := e2eeABI.Pack(method, args...)
+	require.NoError(t, err)
+	contract := vm.NewContract(caller, e2eeContractAddress, uint256.NewInt(0), ec.RequiredGas(input), nil)
+	contract.Input = input
+	bz, err := ec.Run(evm, contract, readonly)
+	if err != nil {
+		return nil, err
+	}
+	return e2eeABI.Methods[method].Outputs.Unpack(bz)
+}
+
+func newRecipient(t *testing.T) string {
+	t.Helper()
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	return identity.Recipient().String()
+}
+
+func TestE2EEContract(t *testing.T) {
+	k, evm := setupE2EEKeeper(t)
+	ec := NewE2EEContract(k, storetypes.KVGasConfig(), true)
+	alice, bob := common.BigToAddress(common.Big1), common.BigToAddress(common.Big2)
+	key := newRecipient(t)
+
+	// the state changing method is rejected in the static calls
+	_, err := runE2EE(t, ec, evm, alice, true, RegisterKeyMethodName, key)
+	require.Error(t, err)
+	_, err = runE2EE(t, ec, evm, alice, false, RegisterKeyMethodName, "invalid")
+	require.Error(t, err)
+
+	out, err := runE2EE(t, ec, evm, alice, false, RegisterKeyMethodName, key)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, out)
+
+	// the key is registered to the caller
+	out, err = runE2EE(t, ec, evm, bob, true, KeyOfMethodName, alice)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{key}, out)
+	out, err = runE2EE(t, ec, evm, bob, true, KeyOfMethodName, bob)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{""}, out)
+
+	out, err = runE2EE(t, ec, evm, bob, true, KeysOfMethodName, []common.Address{bob, alice})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]string{"", key}}, out)
+
+	// the batch is capped like the Keys query
+	_, err = runE2EE(t, ec, evm, bob, true, KeysOfMethodName, make([]common.Address, e2eekeeper.MaxKeysAddresses+1))
+	require.Error(t, err)
+
+	_, err = ec.Run(evm, vm.NewContract(alice, e2eeContractAddress, uint256.NewInt(0), 0, nil), false)
+	require.Error(t, err)
+}
+
+func TestE2EEContractRequiredGas(t *testing.T) {
+	k, _ := setupE2EEKeeper(t)
+	ec := NewE2EEContract(k, storetypes.KVGasConfig(), true)
+	writeCost := storetypes.KVGasConfig().WriteCostPerByte
+
+	input, err := e2eeABI.Pack(RegisterKeyMethodName, newRecipient(t))
+	require.NoError(t, err)
+	require.Equal(t, 50000+uint64(len(input))*writeCost, ec.RequiredGas(input))
+
+	input, err = e2eeABI.Pack(KeyOfMethodName, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, 10000+uint64(len(input))*writeCost, ec.RequiredGas(input))
+
+	// keysOf is charged for each address
+	for _, n := range []int{0, 1, 10} {
+		input, err = e2eeABI.Pack(KeysOfMethodName, make([]common.Address, n))
+		require.NoError(t, err)
+		require.Equal(t, 10000+uint64(n)*KeysOfGasPerAddress+uint64(len(input))*writeCost, ec.RequiredGas(input))
+	}
+
+	// the unknown methods only pay for the input
+	require.Equal(t, 3*writeCost, ec.RequiredGas([]byte{1, 2, 3}))
+	require.Equal(t, 4*writeCost, ec.RequiredGas([]byte{1, 2, 3, 4}))
+}
+
+func TestE2EEContractDisabled(t *testing.T) {
+	k, evm := setupE2EEKeeper(t)
+	ec := NewE2EEContract(k, storetypes.KVGasConfig(), false)
+	alice := common.BigToAddress(common.Big1)
+
+	input, err := e2eeABI.Pack(RegisterKeyMethodName, newRecipient(t))
+	require.NoError(t, err)
+	require.Zero(t, ec.RequiredGas(input))
+
+	// it behaves like an empty account, nothing is registered
+	contract := vm.NewContract(alice, e2eeContractAddress, uint256.NewInt(0), 0, nil)
+	contract.Input = input
+	bz, err := ec.Run(evm, contract, false)
+	require.NoError(t, err)
+	require.Empty(t, bz)
+
+	out, err := runE2EE(t, NewE2EEContract(k, storetypes.KVGasConfig(), true), evm, alice, true, KeyOfMethodName, alice)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{""}, out)
+}
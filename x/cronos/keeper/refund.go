@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxRefundRetriesPerBlock bounds the number of pending refund conversions loaded in one EndBlock,
+	// independently of the gas budget.
+	MaxRefundRetriesPerBlock = 100
+	// MaxRefundRetryBackoff is the maximum number of blocks between two retries of the same conversion.
+	MaxRefundRetryBackoff = int64(14400)
+)
+
+// GetPendingRefundConversion returns the pending refund conversion of the sender for the denom
+func (k Keeper) GetPendingRefundConversion(ctx sdk.Context, sender sdk.AccAddress, denom string) (types.PendingRefundConversion, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingRefundConversionKey(sender, denom))
+	if len(bz) == 0 {
+		return types.PendingRefundConversion{}, false
+	}
+	var entry types.PendingRefundConversion
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// SetPendingRefundConversion stores a pending refund conversion, indexed by its next retry height
+func (k Keeper) SetPendingRefundConversion(ctx sdk.Context, entry types.PendingRefundConversion) error {
+	sender, err := sdk.AccAddressFromBech32(entry.Sender)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if old, found := k.GetPendingRefundConversion(ctx, sender, entry.Amount.Denom); found {
+		store.Delete(types.PendingRefundConversionByHeightKey(old.NextRetryHeight, sender, entry.Amount.Denom))
+	}
+	store.Set(types.PendingRefundConversionKey(sender, entry.Amount.Denom), k.cdc.MustMarshal(&entry))
+	store.Set(types.PendingRefundConversionByHeightKey(entry.NextRetryHeight, sender, entry.Amount.Denom), []byte{1})
+	return nil
+}
+
+func (k Keeper) deletePendingRefundConversion(ctx sdk.Context, sender sdk.AccAddress, entry types.PendingRefundConversion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingRefundConversionKey(sender, entry.Amount.Denom))
+	store.Delete(types.PendingRefundConversionByHeightKey(entry.NextRetryHeight, sender, entry.Amount.Denom))
+}
+
+// GetPendingRefundConversionsByAddress returns all the pending refund conversions of the sender
+func (k Keeper) GetPendingRefundConversionsByAddress(ctx sdk.Context, sender sdk.AccAddress) (out []types.PendingRefundConversion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRefundConversionsKey(sender))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.PendingRefundConversion
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		out = append(out, entry)
+	}
+	return out
+}
+
+// GetAllPendingRefundConversions returns all the pending refund conversions
+func (k Keeper) GetAllPendingRefundConversions(ctx sdk.Context) (out []types.PendingRefundConversion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRefundConversion)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.PendingRefundConversion
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		out = append(out, entry)
+	}
+	return out
+}
+
+// RecordFailedRefundConversion records a refund whose voucher conversion failed so it's retried at EndBlock,
+// it's merged into the pending conversion of the same sender and denom if there's one.
+func (k Keeper) RecordFailedRefundConversion(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, reason error) error {
+	entry, found := k.GetPendingRefundConversion(ctx, sender, coin.Denom)
+	if found {
+		entry.Amount = entry.Amount.Add(coin)
+	} else {
+		entry = types.PendingRefundConversion{
+			Sender: sender.String(),
+			Amount: coin,
+		}
+	}
+	entry.NextRetryHeight = ctx.BlockHeight() + 1
+	entry.LastError = reason.Error()
+	if err := k.SetPendingRefundConversion(ctx, entry); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewRefundConversionFailedEvent(entry.Sender, coin, entry.LastError))
+	return nil
+}
+
+// RetryPendingRefundConversions retries the due pending refund conversions until the gas budget
+// configured in params is exhausted, it's called at EndBlock.
+func (k Keeper) RetryPendingRefundConversions(ctx sdk.Context) {
+	budget := k.GetParams(ctx).MaxRefundRetryGas
+	if budget == 0 {
+		return
+	}
+
+	// collect the due entries first, the store is mutated while retrying them, the index is ordered by the next
+	// retry height so only the due entries are visited.
+	var due []types.PendingRefundConversion
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.KeyPrefixPendingRefundConversionByHeight,
+		types.PendingRefundConversionsByHeightKey(ctx.BlockHeight()+1),
+	)
+	for ; iter.Valid() && len(due) < MaxRefundRetriesPerBlock; iter.Next() {
+		// the key is the prefix, the height, the length prefixed sender and the denom
+		key := iter.Key()[len(types.KeyPrefixPendingRefundConversionByHeight)+8:]
+		sender := sdk.AccAddress(key[1 : 1+int(key[0])])
+		denom := string(key[1+int(key[0]):])
+		entry, found := k.GetPendingRefundConversion(ctx, sender, denom)
+		if !found {
+			// can't happen, the index is maintained together with the entries
+			continue
+		}
+		due = append(due, entry)
+	}
+	iter.Close()
+
+	var gasUsed uint64
+	for _, entry := range due {
+		if gasUsed >= budget {
+			break
+		}
+		gasUsed += k.retryRefundConversion(ctx, entry, budget-gasUsed)
+	}
+}
+
+// retryRefundConversion retries a single pending refund conversion within the gas limit,
+// returns the gas consumed by the attempt.
+func (k Keeper) retryRefundConversion(ctx sdk.Context, entry types.PendingRefundConversion, gasLimit uint64) uint64 {
+	sender, err := sdk.AccAddressFromBech32(entry.Sender)
+	if err != nil {
+		// can't happen, the address is validated when the entry is stored
+		k.Logger(ctx).Error("invalid pending refund conversion", "sender", entry.Sender, "error", err)
+		return 0
+	}
+	denom := entry.Amount.Denom
+
+	// the sender might have spent or converted the refunded vouchers in the meantime,
+	// only the remaining balance is converted.
+	balance := k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(denom)
+	if !balance.IsPositive() {
+		k.deletePendingRefundConversion(ctx, sender, entry)
+		return 0
+	}
+	coin := entry.Amount
+	if balance.LT(coin.Amount) {
+		coin.Amount = balance
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	err = k.convertWithGasMeter(ctx, gasMeter, sender, coin)
+	if err == nil {
+		k.deletePendingRefundConversion(ctx, sender, entry)
+		ctx.EventManager().EmitEvent(types.NewRefundConversionRetriedEvent(entry.Sender, coin, true))
+		return gasMeter.GasConsumedToLimit()
+	}
+
+	entry.Attempts++
+	entry.LastError = err.Error()
+	backoff := MaxRefundRetryBackoff
+	if entry.Attempts < 32 {
+		backoff = min(int64(1)<<entry.Attempts, MaxRefundRetryBackoff)
+	}
+	entry.NextRetryHeight = ctx.BlockHeight() + backoff
+	if err := k.SetPendingRefundConversion(ctx, entry); err != nil {
+		k.Logger(ctx).Error("failed to update pending refund conversion", "sender", entry.Sender, "error", err)
+	}
+	ctx.EventManager().EmitEvent(types.NewRefundConversionRetriedEvent(entry.Sender, coin, false))
+	return gasMeter.GasConsumedToLimit()
+}
+
+// convertWithGasMeter converts the vouchers in a cached context metered by the gas meter,
+// the state changes are only committed on success.
+func (k Keeper) convertWithGasMeter(ctx sdk.Context, gasMeter storetypes.GasMeter, sender sdk.AccAddress, coin sdk.Coin) (err error) {
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", oog.Descriptor)
+		}
+	}()
+
+	if err := k.ConvertVouchersToEvmCoins(cacheCtx, sender.String(), sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	commit()
+	return nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestRetryPendingRefundConversions() {
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	refund := sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		postCheck func()
+	}{
+		{
+			"retry disabled by zero gas budget",
+			func() {
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.MaxRefundRetryGas = 0
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+				suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(refund)))
+			},
+			func() {
+				_, found := suite.app.CronosKeeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(refund.Amount, suite.GetBalance(address, refund.Denom).Amount)
+			},
+		},
+		{
+			"refunded vouchers already spent, entry dropped",
+			func() {},
+			func() {
+				_, found := suite.app.CronosKeeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+				suite.Require().False(found)
+			},
+		},
+		{
+			"refunded vouchers converted",
+			func() {
+				suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(refund)))
+			},
+			func() {
+				_, found := suite.app.CronosKeeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+				suite.Require().False(found)
+				suite.Require().True(suite.GetBalance(address, refund.Denom).Amount.IsZero())
+				suite.Require().Equal(sdkmath.NewInt(1000000000000), suite.GetBalance(address, suite.evmParam.EvmDenom).Amount)
+			},
+		},
+		{
+			"only the remaining balance is converted",
+			func() {
+				suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(sdk.NewCoin(refund.Denom, sdkmath.NewInt(40)))))
+			},
+			func() {
+				_, found := suite.app.CronosKeeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+				suite.Require().False(found)
+				suite.Require().Equal(sdkmath.NewInt(400000000000), suite.GetBalance(address, suite.evmParam.EvmDenom).Amount)
+			},
+		},
+		{
+			"retry not due yet",
+			func() {
+				suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(refund)))
+				suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() - 1)
+			},
+			func() {
+				entry, found := suite.app.CronosKeeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(uint32(0), entry.Attempts)
+			},
+		},
+		{
+			"failed retry is backed off",
+			func() {
+				suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(refund)))
+				params := suite.app.CronosKeeper.GetParams(suite.ctx)
+				params.IbcCroDenom = "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0866"
+				suite.Require().NoError(suite.app.CronosKeeper.SetParams(suite.ctx, params))
+			},
+			func() {
+				entry, found := suite.app.CronosKeeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(uint32(1), entry.Attempts)
+				suite.Require().Equal(suite.ctx.BlockHeight()+2, entry.NextRetryHeight)
+				suite.Require().Equal(refund.Amount, suite.GetBalance(address, refund.Denom).Amount)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			err := suite.app.CronosKeeper.RecordFailedRefundConversion(
+				suite.ctx, address, refund, errors.New("conversion failed"),
+			)
+			suite.Require().NoError(err)
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+			tc.malleate()
+			suite.app.CronosKeeper.RetryPendingRefundConversions(suite.ctx)
+			tc.postCheck()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecordFailedRefundConversionMerges() {
+	suite.SetupTest()
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	refund := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+
+	keeper := suite.app.CronosKeeper
+	suite.Require().NoError(keeper.RecordFailedRefundConversion(suite.ctx, address, refund, errors.New("first")))
+	suite.Require().NoError(keeper.RecordFailedRefundConversion(suite.ctx, address, refund, errors.New("second")))
+
+	entries := keeper.GetPendingRefundConversionsByAddress(suite.ctx, address)
+	suite.Require().Len(entries, 1)
+	suite.Require().Equal(sdkmath.NewInt(200), entries[0].Amount.Amount)
+	suite.Require().Equal("second", entries[0].LastError)
+
+	rsp, err := keeper.PendingRefundConversions(suite.ctx, &types.QueryPendingRefundConversionsRequest{
+		Address: address.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(entries, rsp.Conversions)
+}
+
+func (suite *KeeperTestSuite) TestRetryPendingRefundConversionsByHeight() {
+	suite.SetupTest()
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	refund := sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(100))
+	keeper := suite.app.CronosKeeper
+
+	suite.Require().NoError(keeper.RecordFailedRefundConversion(suite.ctx, address, refund, errors.New("conversion failed")))
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(refund)))
+	params := keeper.GetParams(suite.ctx)
+	ibcCroDenom := params.IbcCroDenom
+	params.IbcCroDenom = "ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0866"
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	// the failed retry moves the entry to a later height in the index
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	keeper.RetryPendingRefundConversions(suite.ctx)
+	entry, found := keeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), entry.Attempts)
+
+	params.IbcCroDenom = ibcCroDenom
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	suite.ctx = suite.ctx.WithBlockHeight(entry.NextRetryHeight - 1)
+	keeper.RetryPendingRefundConversions(suite.ctx)
+	_, found = keeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockHeight(entry.NextRetryHeight)
+	keeper.RetryPendingRefundConversions(suite.ctx)
+	_, found = keeper.GetPendingRefundConversion(suite.ctx, address, refund.Denom)
+	suite.Require().False(found)
+	suite.Require().True(suite.GetBalance(address, refund.Denom).Amount.IsZero())
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/params"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultReplayTraceTimeout is the timeout of tracing a replayed message when not specified in the trace config.
+const DefaultReplayTraceTimeout = 5 * time.Second
+
+// traceReplayMsg executes the replayed eth message with the tracer in the trace config the same way as the untraced
+// replay does through ApplyTransaction: the evm hooks run in the same branch as the message, which is only committed
+// when both succeed, and the leftover gas is refunded, so the later messages are traced against the same state.
+func (k Keeper) traceReplayMsg(
+	ctx sdk.Context,
+	msg *evmtypes.MsgEthereumTx,
+	ethCfg *params.ChainConfig,
+	baseFee *big.Int,
+	evmDenom string,
+	traceConfig *evmtypes.TraceConfig,
+	txIndex int,
+) ([]byte, error) {
+	tx := msg.AsTransaction()
+	coreMsg, err := core.TransactionToMessage(tx, ethtypes.LatestSignerForChainID(k.evmKeeper.ChainID()), baseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer, err := newReplayTracer(ctx, ethCfg, traceConfig, tx.Hash(), txIndex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	timeout := DefaultReplayTraceTimeout
+	if traceConfig.Timeout != "" {
+		timeout, err = time.ParseDuration(traceConfig.Timeout)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
+		}
+	}
+	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+	defer cancel()
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			tracer.Stop(errors.New("execution timeout"))
+		}
+	}()
+
+	cacheCtx, commit := ctx.CacheContext()
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, coreMsg, tracer.Hooks, true)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to trace tx %s", tx.Hash().Hex())
+	}
+	if !res.Failed() {
+		receipt := &ethtypes.Receipt{
+			Type:             tx.Type(),
+			Status:           ethtypes.ReceiptStatusSuccessful,
+			Logs:             evmtypes.LogsToEthereum(res.Logs),
+			TxHash:           tx.Hash(),
+			GasUsed:          res.GasUsed,
+			BlockHash:        common.BytesToHash(ctx.HeaderHash()),
+			BlockNumber:      big.NewInt(ctx.BlockHeight()),
+			TransactionIndex: uint(txIndex),
+		}
+		if tx.To() == nil {
+			receipt.ContractAddress = crypto.CreateAddress(coreMsg.From, tx.Nonce())
+		}
+		// the failure of the hooks reverts the whole tx, only the fee is charged.
+		if err := k.evmKeeper.PostTxProcessing(cacheCtx, coreMsg, receipt); err != nil {
+			k.Logger(ctx).Debug("replayed tx post processing failed", "hash", tx.Hash().Hex(), "error", err)
+		} else {
+			commit()
+		}
+	}
+	if err := k.evmKeeper.RefundGas(ctx, coreMsg, coreMsg.GasLimit-res.GasUsed, evmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas of tx %s", tx.Hash().Hex())
+	}
+	return tracer.GetResult()
+}
+
+// newReplayTracer creates the go-ethereum tracer by name, the struct logger is used if the name is empty.
+func newReplayTracer(
+	ctx sdk.Context,
+	ethCfg *params.ChainConfig,
+	traceConfig *evmtypes.TraceConfig,
+	txHash common.Hash,
+	txIndex int,
+) (*tracers.Tracer, error) {
+	if traceConfig.Tracer == "" {
+		logConfig := logger.Config{
+			EnableMemory:     traceConfig.EnableMemory,
+			DisableStorage:   traceConfig.DisableStorage,
+			DisableStack:     traceConfig.DisableStack,
+			EnableReturnData: traceConfig.EnableReturnData,
+			Debug:            traceConfig.Debug,
+			Limit:            int(traceConfig.Limit),
+		}
+		structLogger := logger.NewStructLogger(&logConfig)
+		return &tracers.Tracer{
+			Hooks:     structLogger.Hooks(),
+			GetResult: structLogger.GetResult,
+			Stop:      structLogger.Stop,
+		}, nil
+	}
+
+	var tracerConfig json.RawMessage
+	if traceConfig.TracerJsonConfig != "" {
+		tracerConfig = json.RawMessage(traceConfig.TracerJsonConfig)
+	}
+	tracerCtx := &tracers.Context{
+		BlockHash:   common.BytesToHash(ctx.HeaderHash()),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     txIndex,
+		TxHash:      txHash,
+	}
+	return tracers.DefaultDirectory.New(traceConfig.Tracer, tracerCtx, tracerConfig, ethCfg)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDenomScalingDecimals bounds the decimals difference between a denom and its scaling target
+const MaxDenomScalingDecimals = 36
+
+// denomScale is a DenomScaling resolved with the factor between the two denoms
+type denomScale struct {
+	denom       string
+	targetDenom string
+	factor      sdkmath.Int
+}
+
+// GetDenomDecimals returns the decimals of the denom, which is the exponent of the display unit
+// in the bank metadata
+func (k Keeper) GetDenomDecimals(ctx sdk.Context, denom string) (uint32, error) {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrDenomScalingInvalid, "no bank metadata for %s", denom)
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent, nil
+		}
+	}
+	return 0, errorsmod.Wrapf(types.ErrDenomScalingInvalid, "no display unit in the bank metadata of %s", denom)
+}
+
+// IsScaledDenom returns if the denom is converted to another denom by a DenomScaling
+func (k Keeper) IsScaledDenom(ctx sdk.Context, denom string) bool {
+	params := k.GetParams(ctx)
+	if denom == params.IbcCroDenom {
+		return true
+	}
+	for _, scaling := range params.DenomScalings {
+		if scaling.Denom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// getScaleByDenom returns the scaling which converts the denom, ibc cro denom is converted to the evm denom
+// with a 10^10 factor when it has no entry in params.
+func (k Keeper) getScaleByDenom(ctx sdk.Context, params types.Params, evmDenom, denom string) (denomScale, bool, error) {
+	for _, scaling := range params.DenomScalings {
+		if scaling.Denom == denom {
+			scale, err := k.resolveScaling(ctx, scaling)
+			return scale, err == nil, err
+		}
+	}
+	if denom == params.IbcCroDenom {
+		if params.IbcCroDenom == "" {
+			return denomScale{}, false, errorsmod.Wrap(types.ErrIbcCroDenomEmpty, "ibc is disabled")
+		}
+		return defaultIbcCroScale(params, evmDenom), true, nil
+	}
+	return denomScale{}, false, nil
+}
+
+// getScaleByTarget returns the scaling which converts to the target denom, the evm denom is converted back to
+// ibc cro denom with a 10^10 factor when ibc cro denom has no entry in params.
+func (k Keeper) getScaleByTarget(ctx sdk.Context, params types.Params, evmDenom, targetDenom string) (denomScale, bool, error) {
+	ibcCroScaled := false
+	for _, scaling := range params.DenomScalings {
+		if scaling.TargetDenom == targetDenom {
+			scale, err := k.resolveScaling(ctx, scaling)
+			return scale, err == nil, err
+		}
+		if scaling.Denom == params.IbcCroDenom {
+			ibcCroScaled = true
+		}
+	}
+	if targetDenom == evmDenom && !ibcCroScaled {
+		if params.IbcCroDenom == "" {
+			return denomScale{}, false, errorsmod.Wrap(types.ErrIbcCroDenomEmpty, "ibc is disabled")
+		}
+		return defaultIbcCroScale(params, evmDenom), true, nil
+	}
+	return denomScale{}, false, nil
+}
+
+// resolveScaling computes the factor of the scaling from the decimals of the denoms
+func (k Keeper) resolveScaling(ctx sdk.Context, scaling types.DenomScaling) (denomScale, error) {
+	decimals, err := k.GetDenomDecimals(ctx, scaling.Denom)
+	if err != nil {
+		return denomScale{}, err
+	}
+	targetDecimals, err := k.GetDenomDecimals(ctx, scaling.TargetDenom)
+	if err != nil {
+		return denomScale{}, err
+	}
+	if targetDecimals <= decimals || targetDecimals-decimals > MaxDenomScalingDecimals {
+		return denomScale{}, errorsmod.Wrapf(
+			types.ErrDenomScalingInvalid,
+			"%s has %d decimals, %s has %d decimals", scaling.Denom, decimals, scaling.TargetDenom, targetDecimals,
+		)
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(targetDecimals-decimals)), nil)
+	return denomScale{
+		denom:       scaling.Denom,
+		targetDenom: scaling.TargetDenom,
+		factor:      sdkmath.NewIntFromBigInt(factor),
+	}, nil
+}
+
+func defaultIbcCroScale(params types.Params, evmDenom string) denomScale {
+	return denomScale{
+		denom:       params.IbcCroDenom,
+		targetDenom: evmDenom,
+		factor:      sdkmath.NewIntFromBigInt(types.TenPowTen),
+	}
+}
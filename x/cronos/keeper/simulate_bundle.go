@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulateCallGas returns the gas limit of the simulated call, zero means the default gas cap.
+func simulateCallGas(call types.SimulateCall) uint64 {
+	if call.Gas == 0 {
+		return DefaultGasCap
+	}
+	return call.Gas
+}
+
+// applyStateOverride overrides the accounts in the state before the simulation.
+func (k Keeper) applyStateOverride(ctx sdk.Context, overrides types.StateOverride) error {
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	for addr, override := range overrides {
+		account := k.evmKeeper.GetAccount(ctx, addr)
+		if account == nil {
+			account = &statedb.Account{CodeHash: ethtypes.EmptyCodeHash.Bytes()}
+		}
+		if override.Nonce != nil {
+			account.Nonce = uint64(*override.Nonce)
+		}
+		if override.Code != nil {
+			codeHash := crypto.Keccak256(*override.Code)
+			k.evmKeeper.SetCode(ctx, codeHash, *override.Code)
+			account.CodeHash = codeHash
+		}
+		if err := k.evmKeeper.SetAccount(ctx, addr, *account); err != nil {
+			return err
+		}
+		if override.Balance != nil {
+			if err := k.evmKeeper.SetBalance(ctx, addr, override.Balance.ToInt(), evmDenom); err != nil {
+				return err
+			}
+		}
+
+		storage := override.StateDiff
+		if override.State != nil {
+			// replace the whole storage
+			var keys []common.Hash
+			k.evmKeeper.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.evmKeeper.SetState(ctx, addr, key, nil)
+			}
+			storage = override.State
+		}
+		for key, value := range storage {
+			if value == (common.Hash{}) {
+				k.evmKeeper.SetState(ctx, addr, key, nil)
+				continue
+			}
+			k.evmKeeper.SetState(ctx, addr, key, value.Bytes())
+		}
+	}
+	return nil
+}
+
+// simulateCall executes the call like a tx without fee, the evm log handlers are executed after a successful call,
+// and the call is reverted if they fail.
+func (k Keeper) simulateCall(ctx sdk.Context, call types.SimulateCall) (types.SimulateCallResult, error) {
+	if !common.IsHexAddress(call.From) {
+		return types.SimulateCallResult{}, status.Errorf(codes.InvalidArgument, "invalid from address: %s", call.From)
+	}
+	from := common.HexToAddress(call.From)
+	var to *common.Address
+	if call.To != "" {
+		if !common.IsHexAddress(call.To) {
+			return types.SimulateCallResult{}, status.Errorf(codes.InvalidArgument, "invalid to address: %s", call.To)
+		}
+		addr := common.HexToAddress(call.To)
+		to = &addr
+	}
+	value := big.NewInt(0)
+	if !call.Value.IsNil() {
+		value = call.Value.BigInt()
+	}
+
+	msg := &core.Message{
+		From:     from,
+		To:       to,
+		Nonce:    k.evmKeeper.GetNonce(ctx, from),
+		Value:    value,
+		GasLimit: simulateCallGas(call),
+		GasPrice: big.NewInt(0),
+		Data:     call.Data,
+		// simulated calls are not signed txs, skip the limit checks.
+		SkipTransactionChecks: true,
+	}
+
+	// execute in a branch, so the failure of the evm log handlers can revert the call like a real tx.
+	cacheCtx, commit := ctx.CacheContext()
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, nil, true)
+	if err != nil {
+		// the tx would be rejected before execution, so no state changes at all.
+		return types.SimulateCallResult{VmError: err.Error()}, nil
+	}
+
+	result := types.SimulateCallResult{
+		Ret:     res.Ret,
+		VmError: res.VmError,
+		GasUsed: res.GasUsed,
+		Logs:    res.Logs,
+	}
+	reverted := false
+	if !res.Failed() {
+		receipt := &ethtypes.Receipt{
+			Status:      ethtypes.ReceiptStatusSuccessful,
+			Logs:        evmtypes.LogsToEthereum(res.Logs),
+			GasUsed:     res.GasUsed,
+			BlockNumber: big.NewInt(ctx.BlockHeight()),
+			BlockHash:   common.BytesToHash(ctx.HeaderHash()),
+		}
+		if err := k.evmKeeper.PostTxProcessing(cacheCtx, msg, receipt); err != nil {
+			result.VmError = err.Error()
+			result.Logs = nil
+			reverted = true
+		} else {
+			result.Logs = evmtypes.NewLogsFromEth(receipt.Logs)
+		}
+	}
+	if !reverted {
+		result.Events = cacheCtx.EventManager().ABCIEvents()
+		commit()
+	}
+
+	// the nonce is increased even if the call is reverted.
+	if acc := k.accountKeeper.GetAccount(ctx, from.Bytes()); acc != nil {
+		if err := acc.SetSequence(msg.Nonce + 1); err != nil {
+			return types.SimulateCallResult{}, err
+		}
+		k.accountKeeper.SetAccount(ctx, acc)
+	}
+	return result, nil
+}
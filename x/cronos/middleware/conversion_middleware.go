@@ -6,6 +6,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v11/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v11/modules/core/exported"
 	cronoskeeper "github.com/crypto-org-chain/cronos/x/cronos/keeper"
+	cronostypes "github.com/crypto-org-chain/cronos/x/cronos/types"
 
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -141,6 +142,7 @@ func (im IBCConversionModule) OnRecvPacket(
 			)
 			return channeltypes.NewErrorAcknowledgement(err)
 		}
+		cacheCtx.EventManager().EmitEvent(cronostypes.NewIbcConversionEvent(data.Receiver, token, false, nil))
 	}
 	commit()
 	return ack
@@ -178,13 +180,14 @@ func (im IBCConversionModule) OnAcknowledgementPacket(
 					true,
 				); err != nil {
 					// Intentional: log and continue so the IBC refund is not blocked.
-					// Sender keeps the refunded IBC vouchers and can retry conversion manually.
+					// Sender keeps the refunded IBC vouchers, the conversion is retried at EndBlock.
 					im.cronoskeeper.Logger(ctx).Error(
 						"failed to convert refund vouchers on acknowledgement",
 						"denom", denom,
 						"sender", data.Sender,
 						"error", err,
 					)
+					im.recordFailedRefund(ctx, data.Token.Amount, data.Sender, denom, err)
 				}
 			}
 		}
@@ -218,13 +221,14 @@ func (im IBCConversionModule) OnTimeoutPacket(
 				true,
 			); err != nil {
 				// Intentional: log and continue so the IBC refund is not blocked.
-				// Sender keeps the refunded IBC vouchers and can retry conversion manually.
+				// Sender keeps the refunded IBC vouchers, the conversion is retried at EndBlock.
 				im.cronoskeeper.Logger(ctx).Error(
 					"failed to convert refund vouchers on timeout",
 					"denom", denom,
 					"sender", data.Sender,
 					"error", err,
 				)
+				im.recordFailedRefund(ctx, data.Token.Amount, data.Sender, denom, err)
 			}
 		}
 
@@ -247,15 +251,46 @@ func (im IBCConversionModule) convertVouchers(
 			"unable to parse transfer amount (%s) into sdk.Int in middleware", amount)
 	}
 	token := sdk.NewCoin(denom, transferAmount)
+	recipient := receiver
 	if isSender {
-		return im.cronoskeeper.OnRecvVouchers(ctx, sdk.NewCoins(token), sender)
+		recipient = sender
+	}
+	err := im.cronoskeeper.OnRecvVouchers(ctx, sdk.NewCoins(token), recipient)
+	ctx.EventManager().EmitEvent(cronostypes.NewIbcConversionEvent(recipient, token, isSender, err))
+	return err
+}
+
+// recordFailedRefund records the failed refund conversion so it's retried at EndBlock,
+// failing to record it must not block the refund either.
+func (im IBCConversionModule) recordFailedRefund(
+	ctx sdk.Context,
+	amount string,
+	sender string,
+	denom string,
+	reason error,
+) {
+	transferAmount, ok := sdkmath.NewIntFromString(amount)
+	if !ok || !transferAmount.IsPositive() {
+		return
+	}
+	acc, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return
+	}
+	if err := im.cronoskeeper.RecordFailedRefundConversion(
+		ctx, acc, sdk.NewCoin(denom, transferAmount), reason,
+	); err != nil {
+		im.cronoskeeper.Logger(ctx).Error(
+			"failed to record refund conversion for retry",
+			"denom", denom,
+			"sender", sender,
+			"error", err,
+		)
 	}
-	return im.cronoskeeper.OnRecvVouchers(ctx, sdk.NewCoins(token), receiver)
 }
 
 func (im IBCConversionModule) canBeConverted(ctx sdk.Context, denom string) bool {
-	params := im.cronoskeeper.GetParams(ctx)
-	if denom == params.IbcCroDenom {
+	if im.cronoskeeper.IsScaledDenom(ctx, denom) {
 		return true
 	}
 	_, found := im.cronoskeeper.GetContractByDenom(ctx, denom)
@@ -88,11 +88,11 @@ func setupMiddlewareContext(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress
 	return testApp, ctx, sender, receiver
 }
 
-func setupMiddlewareTest(t *testing.T) (cronosmiddleware.IBCConversionModule, sdk.Context, sdk.AccAddress, sdk.AccAddress) {
+func setupMiddlewareTest(t *testing.T) (*app.App, cronosmiddleware.IBCConversionModule, sdk.Context, sdk.AccAddress, sdk.AccAddress) {
 	t.Helper()
 	testApp, ctx, sender, receiver := setupMiddlewareContext(t)
 	im := cronosmiddleware.NewIBCConversionModule(noopIBCModule{}, testApp.CronosKeeper)
-	return im, ctx, sender, receiver
+	return testApp, im, ctx, sender, receiver
 }
 
 func buildRefundPacket(t *testing.T, sender, receiver sdk.AccAddress) channeltypes.Packet {
@@ -119,7 +119,7 @@ func buildRefundPacket(t *testing.T, sender, receiver sdk.AccAddress) channeltyp
 // transfer module's ack result (nil here) is what is returned — the conversion
 // error must not surface and block the refund.
 func TestIBCConversionMiddleware_OnAcknowledgementPacket_RefundConversionFailure(t *testing.T) {
-	im, ctx, sender, receiver := setupMiddlewareTest(t)
+	testApp, im, ctx, sender, receiver := setupMiddlewareTest(t)
 	packet := buildRefundPacket(t, sender, receiver)
 
 	errAck := channeltypes.NewErrorAcknowledgement(errors.New("packet failed"))
@@ -128,15 +128,27 @@ func TestIBCConversionMiddleware_OnAcknowledgementPacket_RefundConversionFailure
 
 	err = im.OnAcknowledgementPacket(ctx, transferTypes.V1, packet, ackBz, sdk.AccAddress{})
 	require.NoError(t, err, "refund ack path must not propagate conversion error")
+	requirePendingRefund(t, testApp, ctx, sender)
 }
 
 // Timeout refund path: same log-and-continue contract as the ack path.
 func TestIBCConversionMiddleware_OnTimeoutPacket_RefundConversionFailure(t *testing.T) {
-	im, ctx, sender, receiver := setupMiddlewareTest(t)
+	testApp, im, ctx, sender, receiver := setupMiddlewareTest(t)
 	packet := buildRefundPacket(t, sender, receiver)
 
 	err := im.OnTimeoutPacket(ctx, transferTypes.V1, packet, sdk.AccAddress{})
 	require.NoError(t, err, "refund timeout path must not propagate conversion error")
+	requirePendingRefund(t, testApp, ctx, sender)
+}
+
+// requirePendingRefund checks the failed refund conversion is recorded for retry.
+func requirePendingRefund(t *testing.T, testApp *app.App, ctx sdk.Context, sender sdk.AccAddress) {
+	t.Helper()
+	entry, found := testApp.CronosKeeper.GetPendingRefundConversion(ctx, sender, cronostypes.IbcCroDenomDefaultValue)
+	require.True(t, found, "failed refund conversion must be recorded for retry")
+	require.Equal(t, "100", entry.Amount.Amount.String())
+	require.Equal(t, ctx.BlockHeight()+1, entry.NextRetryHeight)
+	require.NotEmpty(t, entry.LastError)
 }
 
 func TestIBCConversionMiddleware_OnRecvPacket_ConversionFailureRollsBackRecv(t *testing.T) {
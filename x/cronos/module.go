@@ -31,6 +31,7 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 	// this line is used by starport scaffolding # ibc/module/interface
 )
 
@@ -166,6 +167,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock retries the failed refund conversions within the gas budget set in params.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	am.keeper.RetryPendingRefundConversions(sdk.UnwrapSDKContext(goCtx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -0,0 +1,132 @@
+package rpc
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAccountStatusNonces bounds the nonces listed in each field of cronos_accountStatus.
+const MaxAccountStatusNonces = 256
+
+// AccountStatus is the result of cronos_accountStatus, it compares the committed nonce with the views of the
+// app-side mempool and the ante cache.
+type AccountStatus struct {
+	Address        common.Address `json:"address"`
+	CommittedNonce hexutil.Uint64 `json:"committedNonce"`
+	// HighestPooledNonce is nil if the sender has no pooled txs.
+	HighestPooledNonce *hexutil.Uint64  `json:"highestPooledNonce"`
+	PooledNonces       []hexutil.Uint64 `json:"pooledNonces"`
+	// StaleNonces are pooled below the committed nonce, they are evicted by the next recheck.
+	StaleNonces []hexutil.Uint64 `json:"staleNonces"`
+	// Gaps are the missing nonces between the committed nonce and the highest pooled nonce.
+	Gaps []hexutil.Uint64 `json:"gaps"`
+	// QueuedBehindGap are the pooled nonces which can't be included until the gaps are filled.
+	QueuedBehindGap []hexutil.Uint64 `json:"queuedBehindGap"`
+	// AnteCacheOnly are the nonces reserved in the ante cache without a pooled tx, a new tx with these nonces
+	// is rejected by the check tx until the reservations are cleared.
+	AnteCacheOnly []hexutil.Uint64 `json:"anteCacheOnly"`
+	// RecheckStaged reports whether the sender is staged for the next recheck.
+	RecheckStaged bool `json:"recheckStaged"`
+	// RecheckDeferred reports whether a tx of the sender is carried over to the next recheck.
+	RecheckDeferred bool `json:"recheckDeferred"`
+	// Blocklisted is nil if the node can't decrypt the block list, the list itself is never revealed.
+	Blocklisted *bool `json:"blocklisted"`
+}
+
+// AccountStatus returns the nonce and pending state of the account, to diagnose the "nonce too high/low"
+// errors caused by the difference between the committed state, the app-side mempool and the ante cache.
+func (api *CronosAPI) AccountStatus(address common.Address) (*AccountStatus, error) {
+	api.logger.Debug("cronos_accountStatus", "address", address)
+	mempool, err := getAppMempool()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.queryClient.Account(
+		rpctypes.ContextWithHeight(int64(latest)),
+		&evmtypes.QueryAccountRequest{Address: address.Hex()},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := sdk.AccAddress(address.Bytes()).String()
+	pool := mempool.SenderStatus(sender)
+	status := newAccountStatus(address, res.Nonce, pool.Nonces, func(nonce uint64) bool {
+		return mempool.AnteCached(sender, nonce)
+	})
+	status.RecheckStaged = pool.RecheckStaged
+	status.RecheckDeferred = pool.Deferred
+	if blocked, known := mempool.IsBlocked(sender); known {
+		status.Blocklisted = &blocked
+	}
+	return status, nil
+}
+
+// newAccountStatus compares the committed nonce with the pooled nonces in ascending order, the ante cache
+// reservations are probed from the committed nonce up to the next nonce after the pooled ones.
+func newAccountStatus(address common.Address, committed uint64, pooled []uint64, anteCached func(uint64) bool) *AccountStatus {
+	status := &AccountStatus{
+		Address:         address,
+		CommittedNonce:  hexutil.Uint64(committed),
+		PooledNonces:    []hexutil.Uint64{},
+		StaleNonces:     []hexutil.Uint64{},
+		Gaps:            []hexutil.Uint64{},
+		QueuedBehindGap: []hexutil.Uint64{},
+		AnteCacheOnly:   []hexutil.Uint64{},
+	}
+	inPool := make(map[uint64]struct{}, len(pooled))
+	pending := false
+	highest := committed
+	for _, nonce := range pooled {
+		inPool[nonce] = struct{}{}
+		status.PooledNonces = appendNonce(status.PooledNonces, nonce)
+		if nonce < committed {
+			status.StaleNonces = appendNonce(status.StaleNonces, nonce)
+			continue
+		}
+		pending = true
+		highest = max(highest, nonce)
+	}
+	if len(pooled) > 0 {
+		h := hexutil.Uint64(pooled[len(pooled)-1])
+		status.HighestPooledNonce = &h
+	}
+
+	end := committed
+	if pending {
+		end = highest + 1
+	}
+	gapped := false
+	for nonce := committed; nonce <= end && nonce-committed < MaxAccountStatusNonces; nonce++ {
+		if _, ok := inPool[nonce]; ok {
+			if gapped {
+				status.QueuedBehindGap = appendNonce(status.QueuedBehindGap, nonce)
+			}
+			continue
+		}
+		if pending && nonce < highest {
+			status.Gaps = appendNonce(status.Gaps, nonce)
+			gapped = true
+		}
+		if anteCached(nonce) {
+			status.AnteCacheOnly = appendNonce(status.AnteCacheOnly, nonce)
+		}
+	}
+	return status
+}
+
+// appendNonce appends the nonce unless the list reaches MaxAccountStatusNonces.
+func appendNonce(nonces []hexutil.Uint64, nonce uint64) []hexutil.Uint64 {
+	if len(nonces) >= MaxAccountStatusNonces {
+		return nonces
+	}
+	return append(nonces, hexutil.Uint64(nonce))
+}
@@ -4,21 +4,19 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strings"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/blockquery"
 	"github.com/crypto-org-chain/cronos/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	evmrpc "github.com/evmos/ethermint/rpc"
 	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/stream"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
-	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"cosmossdk.io/log/v2"
 
@@ -32,7 +30,7 @@ const (
 
 	apiVersion = "1.0"
 
-	ExceedBlockGasLimitError = "out of gas in location: block gas meter; gasWanted:"
+	ExceedBlockGasLimitError = blockquery.ExceedBlockGasLimitError
 )
 
 func init() {
@@ -42,13 +40,13 @@ func init() {
 }
 
 // CreateCronosRPCAPIs creates extension json-rpc apis
-func CreateCronosRPCAPIs(ctx *server.Context, clientCtx client.Context, _ *stream.RPCStream, allowUnprotectedTxs bool, indexer ethermint.EVMTxIndexer) []rpc.API {
+func CreateCronosRPCAPIs(ctx *server.Context, clientCtx client.Context, evtStream *stream.RPCStream, allowUnprotectedTxs bool, indexer ethermint.EVMTxIndexer) []rpc.API {
 	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 	return []rpc.API{
 		{
 			Namespace: CronosNamespace,
 			Version:   apiVersion,
-			Service:   NewCronosAPI(ctx.Logger, clientCtx, *evmBackend),
+			Service:   NewCronosAPI(ctx.Logger, clientCtx, *evmBackend, evtStream),
 			Public:    true,
 		},
 	}
@@ -63,6 +61,7 @@ type CronosAPI struct {
 	logger            log.Logger
 	backend           backend.Backend
 	cronosQueryClient types.QueryClient
+	evtStream         *stream.RPCStream
 }
 
 // NewCronosAPI creates an instance of the cronos web3 extension apis.
@@ -70,6 +69,7 @@ func NewCronosAPI(
 	logger log.Logger,
 	clientCtx client.Context,
 	backend backend.Backend,
+	evtStream *stream.RPCStream,
 ) *CronosAPI {
 	eip155ChainID, err := ethermint.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -83,292 +83,166 @@ func NewCronosAPI(
 		logger:            logger.With("client", "json-rpc"),
 		backend:           backend,
 		cronosQueryClient: types.NewQueryClient(clientCtx),
+		evtStream:         evtStream,
 	}
 }
 
-func (api *CronosAPI) getBlockDetail(blockNrOrHash rpctypes.BlockNumberOrHash) (
-	resBlock *coretypes.ResultBlock,
-	blockNumber int64,
-	blockHash string,
-	blockRes *coretypes.ResultBlockResults,
-	baseFee *big.Int,
-	err error,
-) {
-	var blockNum rpctypes.BlockNumber
-	resBlock, err = api.getBlock(blockNrOrHash)
+// getBlockDetail loads the block with its results and the evm parameters to build the receipts.
+func (api *CronosAPI) getBlockDetail(blockNrOrHash rpctypes.BlockNumberOrHash) (*blockquery.Block, error) {
+	resBlock, err := api.getBlock(blockNrOrHash)
 	if err != nil {
 		api.logger.Debug("block not found", "height", blockNrOrHash, "error", err.Error())
-		return resBlock, blockNumber, blockHash, blockRes, baseFee, err
+		return nil, err
 	}
-	blockNumber = resBlock.Block.Height
-	blockHash = common.BytesToHash(resBlock.Block.Header.Hash()).Hex()
-	blockRes, err = api.backend.TendermintBlockResultByNumber(&blockNumber)
+	blockNumber := resBlock.Block.Height
+	blockRes, err := api.backend.TendermintBlockResultByNumber(&blockNumber)
 	if err != nil {
-		api.logger.Debug("failed to retrieve block results", "height", blockNum, "error", err.Error())
-		return resBlock, blockNumber, blockHash, blockRes, baseFee, err
+		api.logger.Debug("failed to retrieve block results", "height", blockNumber, "error", err.Error())
+		return nil, err
 	}
-	baseFee, err = api.backend.BaseFee(blockRes)
+	baseFee, err := api.backend.BaseFee(blockRes)
 	if err != nil {
-		return resBlock, blockNumber, blockHash, blockRes, baseFee, err
+		return nil, err
 	}
-	return resBlock, blockNumber, blockHash, blockRes, baseFee, err
+	return &blockquery.Block{
+		Block:   resBlock.Block,
+		Results: blockRes,
+		Hash:    common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		BaseFee: baseFee,
+		Signer:  ethtypes.LatestSignerForChainID(api.chainIDEpoch),
+	}, nil
 }
 
 // GetTransactionReceiptsByBlock returns all the transaction receipts included in the block.
 func (api *CronosAPI) GetTransactionReceiptsByBlock(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
 	api.logger.Debug("cronos_getTransactionReceiptsByBlock", "blockNrOrHash", blockNrOrHash)
-	resBlock, blockNumber, blockHash, blockRes, baseFee, err := api.getBlockDetail(blockNrOrHash)
+	block, err := api.getBlockDetail(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := blockquery.BuildEthReceipts(api.clientCtx.TxConfig.TxDecoder(), block)
 	if err != nil {
 		return nil, err
 	}
-	var receipts []map[string]interface{}
-	txIndex := uint64(0)
-	cumulativeGasUsed := uint64(0)
-	for i, tx := range resBlock.Block.Txs {
-		txResult := blockRes.TxsResults[i]
+	return newRPCReceipts(block, receipts), nil
+}
 
-		// don't ignore the txs which exceed block gas limit.
-		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
-			continue
+// newRPCReceipts renders the receipts of the block in the json format of eth_getTransactionReceipt.
+func newRPCReceipts(block *blockquery.Block, receipts []blockquery.Receipt) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(receipts))
+	for _, r := range receipts {
+		var status hexutil.Uint
+		if r.Failed {
+			status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+		} else {
+			status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 		}
+		receipt := map[string]interface{}{
+			// Consensus fields: These fields are defined by the Yellow Paper
+			"status":            status,
+			"cumulativeGasUsed": hexutil.Uint64(r.CumulativeGasUsed),
+			"logsBloom":         ethtypes.CreateBloom(&ethtypes.Receipt{Logs: r.Logs}),
+			"logs":              r.Logs,
 
-		tx, err := api.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			api.logger.Debug("decoding failed", "error", err.Error())
-			return nil, fmt.Errorf("failed to decode tx: %w", err)
-		}
+			// Implementation fields: These fields are added by geth when processing a transaction.
+			// They are stored in the chain database.
+			"transactionHash": r.Tx.Hash(),
+			"contractAddress": nil,
+			"gasUsed":         hexutil.Uint64(r.GasUsed),
 
-		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse tx events: %d:%d, %w", resBlock.Block.Height, i, err)
-		}
+			// Inclusion information: These fields provide information about the inclusion of the
+			// transaction corresponding to this receipt.
+			"blockHash":        block.Hash,
+			"blockNumber":      hexutil.Uint64(block.Block.Height),
+			"transactionIndex": hexutil.Uint64(r.TxIndex),
 
-		if len(parsedTxs.Txs) == 0 {
-			// not an evm tx
-			cumulativeGasUsed += uint64(txResult.GasUsed)
-			continue
+			// sender and receiver (contract or EOA) addreses
+			"from": r.From,
+			"to":   r.Tx.To(),
+			"type": hexutil.Uint(r.Tx.Type()),
 		}
-
-		if len(parsedTxs.Txs) != len(tx.GetMsgs()) {
-			return nil, fmt.Errorf("wrong number of tx events: %d", txIndex)
+		if r.ContractAddress != nil {
+			receipt["contractAddress"] = *r.ContractAddress
 		}
-
-		msgCumulativeGasUsed := uint64(0)
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				api.logger.Debug(fmt.Sprintf("invalid tx type: %T", msg))
-				return nil, fmt.Errorf("invalid tx type: %T", msg)
-			}
-
-			txData := ethMsg.AsTransaction()
-			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
-
-			// Get the transaction result from the log
-			var status hexutil.Uint
-			if txResult.Code != 0 || parsedTx.Failed {
-				status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-			} else {
-				status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-			}
-			from, err := ethMsg.GetSenderLegacy(ethtypes.LatestSignerForChainID(api.chainIDEpoch))
-			if err != nil {
-				return nil, err
-			}
-
-			logs, err := evmtypes.DecodeMsgLogsFromEvents(txResult.Data, txResult.Events, parsedTx.MsgIndex, uint64(blockRes.Height))
-			if err != nil {
-				api.logger.Debug("failed to parse logs", "block", resBlock.Block.Height, "txIndex", txIndex, "msgIndex", msgIndex, "error", err.Error())
-			}
-			if logs == nil {
-				logs = []*ethtypes.Log{}
-			}
-			// msgCumulativeGasUsed includes gas used by the current tx
-			msgCumulativeGasUsed += parsedTx.GasUsed
-			receipt := map[string]interface{}{
-				// Consensus fields: These fields are defined by the Yellow Paper
-				"status":            status,
-				"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed + msgCumulativeGasUsed),
-				"logsBloom":         ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
-				"logs":              logs,
-
-				// Implementation fields: These fields are added by geth when processing a transaction.
-				// They are stored in the chain database.
-				"transactionHash": txData.Hash(),
-				"contractAddress": nil,
-				"gasUsed":         hexutil.Uint64(parsedTx.GasUsed),
-
-				// Inclusion information: These fields provide information about the inclusion of the
-				// transaction corresponding to this receipt.
-				"blockHash":        blockHash,
-				"blockNumber":      hexutil.Uint64(blockNumber),
-				"transactionIndex": hexutil.Uint64(txIndex),
-
-				// sender and receiver (contract or EOA) addreses
-				"from": from,
-				"to":   txData.To(),
-				"type": hexutil.Uint(txData.Type()),
-			}
-
-			// If the to is empty, assume it is a contract creation
-			if txData.To() == nil {
-				receipt["contractAddress"] = crypto.CreateAddress(from, txData.Nonce())
-			}
-			if txData.Type() == ethtypes.DynamicFeeTxType {
-				receipt["effectiveGasPrice"] = hexutil.Big(*ethMsg.GetEffectiveGasPrice(baseFee))
-			}
-			receipts = append(receipts, receipt)
-			txIndex++
+		if r.EffectiveGasPrice != nil {
+			receipt["effectiveGasPrice"] = hexutil.Big(*r.EffectiveGasPrice)
 		}
-		cumulativeGasUsed += msgCumulativeGasUsed
-		msgCumulativeGasUsed = 0
+		result = append(result, receipt)
 	}
+	return result
+}
 
-	return receipts, nil
+// replayBlockContext holds the replay request of a block and the block details needed to render the results.
+type replayBlockContext struct {
+	req                   *types.ReplayBlockRequest
+	block                 *blockquery.Block
+	blockGasLimitExceeded bool
 }
 
-// ReplayBlock return tx receipts by replay all the eth transactions,
-// if postUpgrade is true, the tx that exceeded block gas limit is treated as reverted, otherwise as committed.
-func (api *CronosAPI) ReplayBlock(blockNrOrHash rpctypes.BlockNumberOrHash, postUpgrade bool) ([]map[string]interface{}, error) {
-	api.logger.Debug("cronos_replayBlock", "blockNrOrHash", blockNrOrHash)
-	resBlock, blockNumber, blockHash, blockRes, baseFee, err := api.getBlockDetail(blockNrOrHash)
+// prepareReplayBlock collects the eth messages to replay in the block, returns nil request if there's none.
+func (api *CronosAPI) prepareReplayBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (*replayBlockContext, error) {
+	block, err := api.getBlockDetail(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	blockGasLimitExceeded := false
-	var msgs []*evmtypes.MsgEthereumTx
-	for i, tx := range resBlock.Block.Txs {
-		txResult := blockRes.TxsResults[i]
-		if txResult.Code != 0 {
-			if strings.Contains(txResult.Log, ExceedBlockGasLimitError) {
-				// the tx with ExceedBlockGasLimitErrorPrefix error should not be ignored because:
-				// 1) before the 0.7.0 upgrade, the tx is committed successfully.
-				// 2) after the upgrade, the tx is failed but fee deducted and nonce increased.
-				// there's at most one such case in each block, and it should be the last tx in the block.
-				blockGasLimitExceeded = true
-			} else {
-				continue
-			}
-		}
-
-		tx, err := api.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			api.logger.Debug("decoding failed", "error", err.Error())
-			return nil, fmt.Errorf("failed to decode tx: %w", err)
-		}
-
-		for _, msg := range tx.GetMsgs() {
-			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				continue
-			}
-
-			msgs = append(msgs, ethMsg)
-		}
+	msgs, blockGasLimitExceeded, err := blockquery.ReplayMsgs(api.clientCtx.TxConfig.TxDecoder(), block)
+	if err != nil {
+		api.logger.Debug("failed to collect the replayed msgs", "error", err.Error())
+		return nil, err
+	}
+	replayCtx := &replayBlockContext{
+		block:                 block,
+		blockGasLimitExceeded: blockGasLimitExceeded,
 	}
-	receipts := make([]map[string]interface{}, 0)
 	if len(msgs) == 0 {
-		return receipts, nil
+		return replayCtx, nil
 	}
 
-	req := &types.ReplayBlockRequest{
+	replayCtx.req = &types.ReplayBlockRequest{
 		Msgs:        msgs,
-		BlockNumber: blockNumber,
-		BlockTime:   resBlock.Block.Time,
-		BlockHash:   blockHash,
+		BlockNumber: block.Block.Height,
+		BlockTime:   block.Block.Time,
+		BlockHash:   block.Hash,
 	}
+	return replayCtx, nil
+}
 
+// contextHeight returns the height of the grpc query context to replay the block.
+func (c *replayBlockContext) contextHeight() int64 {
 	// minus one to get the context of block beginning
-	contextHeight := blockNumber - 1
+	contextHeight := c.block.Block.Height - 1
 	if contextHeight < 1 {
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	rsp, err := api.cronosQueryClient.ReplayBlock(rpctypes.ContextWithHeight(contextHeight), req)
+	return contextHeight
+}
+
+// ReplayBlock return tx receipts by replay all the eth transactions,
+// if postUpgrade is true, the tx that exceeded block gas limit is treated as reverted, otherwise as committed.
+func (api *CronosAPI) ReplayBlock(blockNrOrHash rpctypes.BlockNumberOrHash, postUpgrade bool) ([]map[string]interface{}, error) {
+	api.logger.Debug("cronos_replayBlock", "blockNrOrHash", blockNrOrHash)
+	replayCtx, err := api.prepareReplayBlock(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-
-	var cumulativeGasUsed uint64
-	for txIndex, txResponse := range rsp.Responses {
-		ethMsg := msgs[txIndex]
-		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
-		if err != nil {
-			api.logger.Error("failed to unpack tx data", "error", err.Error())
-			return nil, err
-		}
-
-		// Get the transaction result from the log
-		var status hexutil.Uint
-		if txResponse.Failed() {
-			status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-		} else {
-			status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-		}
-
-		from, err := ethMsg.GetSenderLegacy(ethtypes.LatestSignerForChainID(api.chainIDEpoch))
-		if err != nil {
-			return nil, err
-		}
-
-		logs := evmtypes.LogsToEthereum(txResponse.Logs)
-		if logs == nil {
-			logs = []*ethtypes.Log{}
-		}
-
-		// cumulativeGasUsed includes gas used by the current tx
-		cumulativeGasUsed += txResponse.GasUsed
-		receipt := map[string]interface{}{
-			// Consensus fields: These fields are defined by the Yellow Paper
-			"status":            status,
-			"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
-			"logsBloom":         ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
-			"logs":              logs,
-
-			// Implementation fields: These fields are added by geth when processing a transaction.
-			// They are stored in the chain database.
-			"transactionHash": ethMsg.Hash,
-			"contractAddress": nil,
-			"gasUsed":         hexutil.Uint64(txResponse.GasUsed),
-			"type":            hexutil.Uint(txData.TxType()),
-
-			// Inclusion information: These fields provide information about the inclusion of the
-			// transaction corresponding to this receipt.
-			"blockHash":        blockHash,
-			"blockNumber":      hexutil.Uint64(blockNumber),
-			"transactionIndex": hexutil.Uint64(txIndex),
-
-			// sender and receiver (contract or EOA) addreses
-			"from": from,
-			"to":   txData.GetTo(),
-		}
-
-		// If the to is nil, assume it is a contract creation
-		if txData.GetTo() == nil {
-			receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
-		}
-
-		if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
-
-		receipts = append(receipts, receipt)
+	if replayCtx.req == nil {
+		return make([]map[string]interface{}, 0), nil
 	}
 
-	if blockGasLimitExceeded && postUpgrade {
-		// after the 0.7.0 upgrade, the tx is always reverted, fix the last receipt.
-		idx := len(receipts) - 1
-		receipts[idx]["status"] = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-		receipts[idx]["logs"] = []*ethtypes.Log{}
-		receipts[idx]["logsBloom"] = ethtypes.CreateBloom(&ethtypes.Receipt{Logs: []*ethtypes.Log{}})
-		receipts[idx]["contractAddress"] = nil
-		// the fee is deducted by the gas limit, so we patch the gasUsed to gasLimit
-		refundedGas := msgs[idx].GetGas() - uint64(receipts[idx]["gasUsed"].(hexutil.Uint64))
-		receipts[idx]["gasUsed"] = hexutil.Uint64(uint64(receipts[idx]["gasUsed"].(hexutil.Uint64)) + refundedGas)
-		receipts[idx]["cumulativeGasUsed"] = hexutil.Uint64(uint64(receipts[idx]["cumulativeGasUsed"].(hexutil.Uint64)) + refundedGas)
+	rsp, err := api.cronosQueryClient.ReplayBlock(rpctypes.ContextWithHeight(replayCtx.contextHeight()), replayCtx.req)
+	if err != nil {
+		return nil, err
 	}
 
-	return receipts, nil
+	// after the 0.7.0 upgrade, the tx is always reverted.
+	receipts, err := blockquery.ReplayReceipts(
+		replayCtx.block, replayCtx.req.Msgs, rsp.Responses, replayCtx.blockGasLimitExceeded && postUpgrade,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newRPCReceipts(replayCtx.block, receipts), nil
 }
 
 // getBlock returns the block from BlockNumberOrHash
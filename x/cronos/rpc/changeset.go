@@ -0,0 +1,130 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	prefixChangedKey = iota + 1
+	prefixFirstVersion
+)
+
+// changeSetIndexedStores are the stores whose change sets are indexed, the ones read by cronos_stateDiff.
+var changeSetIndexedStores = []string{banktypes.StoreKey, evmtypes.StoreKey}
+
+// ChangeSetReader lists the keys written by the per version change sets.
+type ChangeSetReader interface {
+	// FirstVersion returns the first version whose change set is recorded, false if none is recorded yet.
+	FirstVersion() (int64, bool, error)
+	// ChangedKeys returns the keys under the prefix written in the versions after from up to to, in key order.
+	ChangedKeys(storeKey string, prefix []byte, from, to int64) ([][]byte, error)
+}
+
+// ChangeSetIndex records the keys written by the change sets streamed to versiondb, keyed by the version, the
+// store key and the key, so the changes of an account between two versions are found by one seek per version
+// without reading the whole state at both versions.
+type ChangeSetIndex struct {
+	db dbm.DB
+}
+
+var _ ChangeSetReader = (*ChangeSetIndex)(nil)
+
+// NewChangeSetIndex creates the index on the db.
+func NewChangeSetIndex(db dbm.DB) *ChangeSetIndex {
+	return &ChangeSetIndex{db: db}
+}
+
+// Record indexes the change set of the version, it should be called before the change set is written to
+// versiondb, an indexed key missing in versiondb is only compared as unchanged.
+func (idx *ChangeSetIndex) Record(version int64, changeSet []*storetypes.StoreKVPair) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if _, found, err := idx.FirstVersion(); err != nil {
+		return err
+	} else if !found {
+		if err := batch.Set([]byte{prefixFirstVersion}, binary.BigEndian.AppendUint64(nil, uint64(version))); err != nil {
+			return err
+		}
+	}
+	for _, pair := range changeSet {
+		if !slices.Contains(changeSetIndexedStores, pair.StoreKey) {
+			continue
+		}
+		if err := batch.Set(changedKeyPrefix(version, pair.StoreKey, pair.Key), []byte{}); err != nil {
+			return err
+		}
+	}
+	// like versiondb, it's not synced, a crash replays the change sets after the latest flushed version.
+	return batch.Write()
+}
+
+// Close closes the underlying db.
+func (idx *ChangeSetIndex) Close() error {
+	return idx.db.Close()
+}
+
+// FirstVersion implements ChangeSetReader.
+func (idx *ChangeSetIndex) FirstVersion() (int64, bool, error) {
+	bz, err := idx.db.Get([]byte{prefixFirstVersion})
+	if err != nil || len(bz) == 0 {
+		return 0, false, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true, nil
+}
+
+// ChangedKeys implements ChangeSetReader, at most MaxStateDiffEntries keys and MaxStateDiffVersions versions.
+func (idx *ChangeSetIndex) ChangedKeys(storeKey string, prefix []byte, from, to int64) ([][]byte, error) {
+	if to-from > MaxStateDiffVersions {
+		return nil, fmt.Errorf("too many versions between %d and %d, max %d", from, to, MaxStateDiffVersions)
+	}
+	seen := make(map[string]struct{})
+	var keys [][]byte
+	for version := from + 1; version <= to; version++ {
+		start := changedKeyPrefix(version, storeKey, prefix)
+		storePrefixLen := len(start) - len(prefix)
+		if err := func() error {
+			it, err := idx.db.Iterator(start, storetypes.PrefixEndBytes(start))
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+			for ; it.Valid(); it.Next() {
+				key := it.Key()[storePrefixLen:]
+				if _, ok := seen[string(key)]; ok {
+					continue
+				}
+				if len(keys) >= MaxStateDiffEntries {
+					return fmt.Errorf("too many changes in store %s between version %d and %d, max %d", storeKey, from, to, MaxStateDiffEntries)
+				}
+				seen[string(key)] = struct{}{}
+				keys = append(keys, slices.Clone(key))
+			}
+			return it.Error()
+		}(); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(keys, bytes.Compare)
+	return keys, nil
+}
+
+// changedKeyPrefix returns the index prefix of the key in the store at the version, the store key is length
+// prefixed.
+func changedKeyPrefix(version int64, storeKey string, key []byte) []byte {
+	bz := make([]byte, 0, 10+len(storeKey)+len(key))
+	bz = append(bz, prefixChangedKey)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(version))
+	bz = append(bz, byte(len(storeKey)))
+	bz = append(bz, storeKey...)
+	return append(bz, key...)
+}
@@ -0,0 +1,200 @@
+package rpc
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FeeSuggestionBlocks is the number of recent blocks of the baseFee trend in cronos_suggestFees.
+	FeeSuggestionBlocks = 10
+
+	// maxBaseFeeGrowth is the max baseFee increase per block allowed by eip-1559.
+	maxBaseFeeGrowth = 1.125
+)
+
+// FeeSuggestion is a fee tier of cronos_suggestFees.
+type FeeSuggestion struct {
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
+	// EstimatedInclusionBlock is the block expected to include a tx paying the tier's fees.
+	EstimatedInclusionBlock hexutil.Uint64 `json:"estimatedInclusionBlock"`
+}
+
+// FeeSuggestions is the result of cronos_suggestFees.
+type FeeSuggestions struct {
+	BaseFee *hexutil.Big `json:"baseFee"`
+	// BaseFeeGrowth is the average baseFee change ratio per block over the recent blocks.
+	BaseFeeGrowth float64 `json:"baseFeeGrowth"`
+	// PendingTxs is the number of pooled txs, EligibleTxs is the ones passing the baseFee gate.
+	PendingTxs  int `json:"pendingTxs"`
+	EligibleTxs int `json:"eligibleTxs"`
+	// GateSkipped is the number of txs skipped by the baseFee gate in the recent proposals of this node.
+	GateSkipped   int           `json:"gateSkipped"`
+	GateProposals int           `json:"gateProposals"`
+	Low           FeeSuggestion `json:"low"`
+	Medium        FeeSuggestion `json:"medium"`
+	High          FeeSuggestion `json:"high"`
+}
+
+// SuggestFees suggests the low, medium and high fee caps from the recent baseFee trend and the priority
+// distribution of the app-side mempool. A tx is only proposed when its fee cap covers the baseFee of the
+// inclusion block, so the caps cover the projected baseFee up to the estimated inclusion block, with one
+// more block of margin when the baseFee gate skipped txs recently.
+func (api *CronosAPI) SuggestFees() (*FeeSuggestions, error) {
+	api.logger.Debug("cronos_suggestFees")
+	mempool, err := getAppMempool()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	height := int64(latest)
+	baseFees, err := api.recentBaseFees(height, FeeSuggestionBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if len(baseFees) == 0 {
+		return nil, errors.New("baseFee is not enabled")
+	}
+	baseFee := baseFees[len(baseFees)-1]
+	growth := baseFeeGrowth(baseFees)
+
+	res, err := api.queryClient.Params(rpctypes.ContextWithHeight(height), &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pending := mempool.PendingTxs()
+	tips := pendingTips(pending, baseFee, res.Params.EvmDenom)
+	defaultTip, err := api.backend.SuggestGasTipCap(baseFee)
+	if err != nil {
+		return nil, err
+	}
+	skipped, proposals := mempool.GateSkipStats()
+	margin := 0
+	if skipped > 0 {
+		margin = 1
+	}
+
+	suggest := func(percentile int) FeeSuggestion {
+		tip := defaultTip
+		if len(tips) > 0 {
+			tip = tips[(len(tips)-1)*percentile/100]
+		}
+		// the pool is reaped in priority order, the txs paying more are included first.
+		ahead := len(tips) - sort.Search(len(tips), func(i int) bool { return tips[i].Cmp(tip) > 0 })
+		wait := 0
+		if perBlock := mempool.MaxTxPerBlock(); perBlock > 0 {
+			wait = ahead / perBlock
+		}
+		feeCap := projectBaseFee(baseFee, growth, wait+1+margin)
+		return FeeSuggestion{
+			MaxFeePerGas:            (*hexutil.Big)(feeCap.Add(feeCap, tip)),
+			MaxPriorityFeePerGas:    (*hexutil.Big)(tip),
+			EstimatedInclusionBlock: hexutil.Uint64(height + 1 + int64(wait)),
+		}
+	}
+
+	return &FeeSuggestions{
+		BaseFee:       (*hexutil.Big)(baseFee),
+		BaseFeeGrowth: growth,
+		PendingTxs:    len(pending),
+		EligibleTxs:   len(tips),
+		GateSkipped:   skipped,
+		GateProposals: proposals,
+		Low:           suggest(25),
+		Medium:        suggest(50),
+		High:          suggest(90),
+	}, nil
+}
+
+// recentBaseFees returns the baseFees of the recent blocks up to height in block order,
+// the blocks without baseFee are skipped.
+func (api *CronosAPI) recentBaseFees(height int64, blocks int64) ([]*big.Int, error) {
+	var baseFees []*big.Int
+	for h := max(1, height-blocks+1); h <= height; h++ {
+		blockRes, err := api.backend.TendermintBlockResultByNumber(&h)
+		if err != nil {
+			return nil, err
+		}
+		baseFee, err := api.backend.BaseFee(blockRes)
+		if err != nil {
+			return nil, err
+		}
+		if baseFee != nil {
+			baseFees = append(baseFees, baseFee)
+		}
+	}
+	return baseFees, nil
+}
+
+// baseFeeGrowth returns the geometric mean of the baseFee change ratio per block.
+func baseFeeGrowth(baseFees []*big.Int) float64 {
+	if len(baseFees) < 2 || baseFees[0].Sign() <= 0 {
+		return 1
+	}
+	first, last := baseFees[0], baseFees[len(baseFees)-1]
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(last), new(big.Float).SetInt(first)).Float64()
+	return math.Pow(ratio, 1/float64(len(baseFees)-1))
+}
+
+// projectBaseFee projects the baseFee after the number of blocks, only a rising trend is followed,
+// and never faster than allowed by eip-1559.
+func projectBaseFee(baseFee *big.Int, growth float64, blocks int) *big.Int {
+	growth = min(max(growth, 1), maxBaseFeeGrowth)
+	factor := new(big.Float).SetFloat64(math.Pow(growth, float64(blocks)))
+	projected, _ := new(big.Float).Mul(new(big.Float).SetInt(baseFee), factor).Int(nil)
+	// round up, so the fee cap is never below the projected baseFee.
+	return projected.Add(projected, big.NewInt(1))
+}
+
+// pendingTips returns the effective priority tips of the pooled txs passing the baseFee gate, in ascending order.
+func pendingTips(txs []sdk.Tx, baseFee *big.Int, evmDenom string) []*big.Int {
+	tips := make([]*big.Int, 0, len(txs))
+	for _, tx := range txs {
+		if tip := effectiveTip(tx, baseFee, evmDenom); tip != nil {
+			tips = append(tips, tip)
+		}
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	return tips
+}
+
+// effectiveTip returns the tip paid on top of the baseFee, nil if the fee cap is below the baseFee, following
+// the proposal baseFee gate the fee cap of the cosmos txs is the fee in evm denom per gas.
+func effectiveTip(tx sdk.Tx, baseFee *big.Int, evmDenom string) *big.Int {
+	msgs := tx.GetMsgs()
+	if len(msgs) > 0 {
+		if ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+			ethTx := ethMsg.AsTransaction()
+			if ethTx == nil || ethTx.GasFeeCap().Cmp(baseFee) < 0 {
+				return nil
+			}
+			tip := new(big.Int).Sub(ethTx.GasFeeCap(), baseFee)
+			if ethTx.GasTipCap().Cmp(tip) < 0 {
+				tip.Set(ethTx.GasTipCap())
+			}
+			return tip
+		}
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return nil
+	}
+	feeCap := feeTx.GetFee().AmountOf(evmDenom).BigInt()
+	feeCap.Quo(feeCap, new(big.Int).SetUint64(feeTx.GetGas()))
+	if feeCap.Cmp(baseFee) < 0 {
+		return nil
+	}
+	return feeCap.Sub(feeCap, baseFee)
+}
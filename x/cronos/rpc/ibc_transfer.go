@@ -0,0 +1,13 @@
+package rpc
+
+import (
+	"github.com/crypto-org-chain/cronos/x/cronos/ibctracker"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+)
+
+// GetIbcTransferStatus returns the ibc transfers sent by the tx and their current status,
+// the tx hash can be either an eth tx hash or a cosmos tx hash.
+func (api *CronosAPI) GetIbcTransferStatus(txHash string) ([]types.IbcTransfer, error) {
+	api.logger.Debug("cronos_getIbcTransferStatus", "txHash", txHash)
+	return ibctracker.QueryTransferStatus(api.ctx, api.clientCtx, txHash)
+}
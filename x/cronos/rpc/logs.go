@@ -0,0 +1,210 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// MaxLogsRangeBlocks is the max number of blocks scanned by one cronos_getLogsByBlockRange call.
+	MaxLogsRangeBlocks = 1000
+	// MaxLogsRangeLogs is the max number of logs returned by one cronos_getLogsByBlockRange call.
+	MaxLogsRangeLogs = 10000
+)
+
+// LogsRangeFilter is the server-side filter of cronos_getLogsByBlockRange,
+// it follows the same matching rules as the address and topics fields of eth_getLogs.
+type LogsRangeFilter struct {
+	Addresses []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// LogsRangeCursor points to the first log not yet returned by cronos_getLogsByBlockRange.
+type LogsRangeCursor struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	LogIndex    hexutil.Uint   `json:"logIndex"`
+}
+
+// LogsRangeResult is the result of cronos_getLogsByBlockRange,
+// Cursor is nil when the whole range is exported.
+type LogsRangeResult struct {
+	Logs   []*ethtypes.Log  `json:"logs"`
+	Cursor *LogsRangeCursor `json:"cursor"`
+}
+
+// GetLogsByBlockRange returns the logs in the block range [from, to] matching the filter, page by page.
+// At most MaxLogsRangeBlocks blocks are scanned and MaxLogsRangeLogs logs are returned by each call,
+// the returned cursor should be passed to the next call to continue the export.
+func (api *CronosAPI) GetLogsByBlockRange(
+	from, to rpctypes.BlockNumber,
+	filter LogsRangeFilter,
+	cursor *LogsRangeCursor,
+) (*LogsRangeResult, error) {
+	api.logger.Debug("cronos_getLogsByBlockRange", "from", from, "to", to, "cursor", cursor)
+	fromHeight, err := api.resolveBlockNumber(from)
+	if err != nil {
+		return nil, err
+	}
+	toHeight, err := api.resolveBlockNumber(to)
+	if err != nil {
+		return nil, err
+	}
+	if fromHeight > toHeight {
+		return nil, fmt.Errorf("invalid block range: from %d is greater than to %d", fromHeight, toHeight)
+	}
+
+	return paginateLogs(fromHeight, toHeight, filter, cursor, api.getBlockLogs, MaxLogsRangeBlocks, MaxLogsRangeLogs)
+}
+
+// paginateLogs collects the logs of the blocks in [fromHeight, toHeight] matching the filter from the cursor,
+// it stops after scanning maxBlocks blocks or collecting maxLogs logs and returns the cursor to continue from.
+func paginateLogs(
+	fromHeight, toHeight int64,
+	filter LogsRangeFilter,
+	cursor *LogsRangeCursor,
+	blockLogs func(height int64) ([]*ethtypes.Log, error),
+	maxBlocks int64,
+	maxLogs int,
+) (*LogsRangeResult, error) {
+	var logIndex uint
+	if cursor != nil {
+		if int64(cursor.BlockNumber) < fromHeight || int64(cursor.BlockNumber) > toHeight {
+			return nil, fmt.Errorf("cursor block %d is out of range [%d, %d]", cursor.BlockNumber, fromHeight, toHeight)
+		}
+		fromHeight = int64(cursor.BlockNumber)
+		logIndex = uint(cursor.LogIndex)
+	}
+
+	result := &LogsRangeResult{Logs: []*ethtypes.Log{}}
+	for height := fromHeight; height <= toHeight; height++ {
+		if height-fromHeight >= maxBlocks {
+			result.Cursor = &LogsRangeCursor{BlockNumber: hexutil.Uint64(height)}
+			return result, nil
+		}
+		logs, err := blockLogs(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range logs {
+			if height == fromHeight && log.Index < logIndex {
+				continue
+			}
+			if !matchLog(log, filter) {
+				continue
+			}
+			if len(result.Logs) >= maxLogs {
+				result.Cursor = &LogsRangeCursor{
+					BlockNumber: hexutil.Uint64(height),
+					LogIndex:    hexutil.Uint(log.Index),
+				}
+				return result, nil
+			}
+			result.Logs = append(result.Logs, log)
+		}
+	}
+	return result, nil
+}
+
+// resolveBlockNumber converts the block number to a concrete height, the special tags resolve to the latest block.
+func (api *CronosAPI) resolveBlockNumber(blockNum rpctypes.BlockNumber) (int64, error) {
+	if blockNum < rpctypes.EthEarliestBlockNumber {
+		latest, err := api.backend.BlockNumber()
+		if err != nil {
+			return 0, err
+		}
+		return int64(latest), nil
+	}
+	if blockNum == rpctypes.EthEarliestBlockNumber {
+		return 1, nil
+	}
+	return blockNum.Int64(), nil
+}
+
+// getBlockLogs decodes the logs of all the eth transactions in the block at height from the block and its results.
+func (api *CronosAPI) getBlockLogs(height int64) ([]*ethtypes.Log, error) {
+	resBlock, err := api.backend.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	blockRes, err := api.backend.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		api.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, err
+	}
+
+	var logs []*ethtypes.Log
+	for i, tx := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[i]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			continue
+		}
+
+		tx, err := api.clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			api.logger.Debug("decoding failed", "error", err.Error())
+			return nil, fmt.Errorf("failed to decode tx: %w", err)
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: %d:%d, %w", height, i, err)
+		}
+
+		for msgIndex := range parsedTxs.Txs {
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil || parsedTx.Failed {
+				continue
+			}
+			msgLogs, err := evmtypes.DecodeMsgLogsFromEvents(txResult.Data, txResult.Events, parsedTx.MsgIndex, uint64(height))
+			if err != nil {
+				api.logger.Debug("failed to parse logs", "block", height, "txIndex", i, "msgIndex", msgIndex, "error", err.Error())
+				continue
+			}
+			logs = append(logs, msgLogs...)
+		}
+	}
+	return logs, nil
+}
+
+// matchLog checks the log against the address and topics criteria of the filter.
+func matchLog(log *ethtypes.Log, filter LogsRangeFilter) bool {
+	if len(filter.Addresses) > 0 && !containsAddress(filter.Addresses, log.Address) {
+		return false
+	}
+	if len(filter.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range filter.Topics {
+		// empty position matches any topic
+		if len(sub) == 0 {
+			continue
+		}
+		if !containsHash(sub, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
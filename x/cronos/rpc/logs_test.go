@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	logsTestContract1 = common.BigToAddress(common.Big1)
+	logsTestContract2 = common.BigToAddress(common.Big2)
+)
+
+// testBlockLogs returns 3 logs for each block, the second one is emitted by the second contract.
+func testBlockLogs(height int64) ([]*ethtypes.Log, error) {
+	logs := make([]*ethtypes.Log, 3)
+	for i := range logs {
+		addr := logsTestContract1
+		if i == 1 {
+			addr = logsTestContract2
+		}
+		logs[i] = &ethtypes.Log{Address: addr, BlockNumber: uint64(height), Index: uint(i)}
+	}
+	return logs, nil
+}
+
+func TestPaginateLogs(t *testing.T) {
+	all, err := paginateLogs(1, 5, LogsRangeFilter{}, nil, testBlockLogs, 1000, 1000)
+	require.NoError(t, err)
+	require.Len(t, all.Logs, 15)
+	require.Nil(t, all.Cursor)
+
+	// the pages follow each other without gap or duplicate
+	var (
+		cursor *LogsRangeCursor
+		logs   []*ethtypes.Log
+		pages  int
+	)
+	for {
+		page, err := paginateLogs(1, 5, LogsRangeFilter{}, cursor, testBlockLogs, 1000, 4)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Logs), 4)
+		logs = append(logs, page.Logs...)
+		pages++
+		if page.Cursor == nil {
+			break
+		}
+		cursor = page.Cursor
+	}
+	require.Equal(t, 4, pages)
+	require.Equal(t, all.Logs, logs)
+
+	page, err := paginateLogs(1, 5, LogsRangeFilter{}, nil, testBlockLogs, 1000, 4)
+	require.NoError(t, err)
+	require.Equal(t, &LogsRangeCursor{BlockNumber: 2, LogIndex: 1}, page.Cursor)
+}
+
+func TestPaginateLogsBlockLimit(t *testing.T) {
+	page, err := paginateLogs(1, 5, LogsRangeFilter{}, nil, testBlockLogs, 2, 1000)
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 6)
+	require.Equal(t, &LogsRangeCursor{BlockNumber: 3}, page.Cursor)
+
+	// the block limit counts from the cursor
+	page, err = paginateLogs(1, 5, LogsRangeFilter{}, page.Cursor, testBlockLogs, 2, 1000)
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 6)
+	require.Equal(t, uint64(3), page.Logs[0].BlockNumber)
+	require.Equal(t, &LogsRangeCursor{BlockNumber: 5}, page.Cursor)
+}
+
+func TestPaginateLogsFilter(t *testing.T) {
+	page, err := paginateLogs(1, 5, LogsRangeFilter{Addresses: []common.Address{logsTestContract2}}, nil, testBlockLogs, 1000, 2)
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 2)
+	for _, log := range page.Logs {
+		require.Equal(t, logsTestContract2, log.Address)
+	}
+	// the cursor points to the next matching log
+	require.Equal(t, &LogsRangeCursor{BlockNumber: 3, LogIndex: 1}, page.Cursor)
+}
+
+func TestPaginateLogsInvalidCursor(t *testing.T) {
+	for _, cursor := range []*LogsRangeCursor{
+		{BlockNumber: hexutil.Uint64(0)},
+		{BlockNumber: hexutil.Uint64(6)},
+	} {
+		_, err := paginateLogs(1, 5, LogsRangeFilter{}, cursor, testBlockLogs, 1000, 1000)
+		require.Error(t, err)
+	}
+}
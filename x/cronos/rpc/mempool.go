@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"errors"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errAppMempoolUnavailable is returned by the apis relying on the app-side mempool when it's not registered,
+// e.g. the node doesn't run with mempool.type=app.
+var errAppMempoolUnavailable = errors.New("app-side mempool is not available, requires mempool.type=app")
+
+// AppMempool is the in-process view of the app-side mempool served by the cronos namespace.
+type AppMempool interface {
+	// PendingTxs returns a snapshot of the pooled txs in priority order.
+	PendingTxs() []sdk.Tx
+	// MaxTxPerBlock returns the max number of txs reaped per block, 0 means unlimited.
+	MaxTxPerBlock() int
+	// GateSkipStats returns the number of txs skipped by the proposal baseFee gate in the recent proposals
+	// prepared by this node, and the number of these proposals.
+	GateSkipStats() (skipped, proposals int)
+	// SenderStatus returns the pool view of the sender in bech32.
+	SenderStatus(sender string) MempoolSenderStatus
+	// AnteCached reports whether the ante cache reserved the nonce of the sender in bech32.
+	AnteCached(sender string, nonce uint64) bool
+	// IsBlocked reports whether the sender in bech32 is blocklisted, known is false if the node can't
+	// decrypt the block list.
+	IsBlocked(sender string) (blocked, known bool)
+}
+
+// MempoolSenderStatus is the pool view of a sender.
+type MempoolSenderStatus struct {
+	// Nonces are the nonces of the pooled txs of the sender, in ascending order.
+	Nonces []uint64
+	// RecheckStaged reports whether the sender is staged for the next recheck.
+	RecheckStaged bool
+	// Deferred reports whether a tx of the sender is carried over to the next recheck.
+	Deferred bool
+}
+
+var (
+	appMempoolMu sync.RWMutex
+	appMempool   AppMempool
+)
+
+// SetAppMempool registers the app-side mempool, it's set by the app when the json-rpc server runs in the
+// same process.
+func SetAppMempool(m AppMempool) {
+	appMempoolMu.Lock()
+	defer appMempoolMu.Unlock()
+	appMempool = m
+}
+
+// getAppMempool returns the registered app-side mempool.
+func getAppMempool() (AppMempool, error) {
+	appMempoolMu.RLock()
+	defer appMempoolMu.RUnlock()
+	if appMempool == nil {
+		return nil, errAppMempoolUnavailable
+	}
+	return appMempool, nil
+}
@@ -0,0 +1,204 @@
+package rpc
+
+import (
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/blockquery"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ReceiptTxTypeEthereum marks the receipts of eth transactions in cronos_getBlockReceiptsWithCosmosEvents.
+	ReceiptTxTypeEthereum = "ethereum"
+	// ReceiptTxTypeCosmos marks the receipts of cosmos transactions in cronos_getBlockReceiptsWithCosmosEvents.
+	ReceiptTxTypeCosmos = "cosmos"
+
+	// msgIndexAttribute is appended by baseapp to the events emitted by each msg.
+	msgIndexAttribute = "msg_index"
+)
+
+// GetBlockReceiptsWithCosmosEvents returns a receipt for every tx included in the block, in block order.
+// The eth transactions share the format of cronos_getTransactionReceiptsByBlock, enriched with the native
+// events emitted by the same msg, the other txs are rendered in a receipt-like format with the msg types,
+// signers, fee and all the events.
+func (api *CronosAPI) GetBlockReceiptsWithCosmosEvents(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	api.logger.Debug("cronos_getBlockReceiptsWithCosmosEvents", "blockNrOrHash", blockNrOrHash)
+	block, err := api.getBlockDetail(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return api.blockReceiptsWithCosmosEvents(block)
+}
+
+// blockReceiptsWithCosmosEvents builds the receipts of all the txs of the block from its results.
+func (api *CronosAPI) blockReceiptsWithCosmosEvents(block *blockquery.Block) ([]map[string]interface{}, error) {
+	receipts, err := blockquery.BuildEthReceipts(api.clientCtx.TxConfig.TxDecoder(), block)
+	if err != nil {
+		return nil, err
+	}
+	ethReceipts := newRPCReceipts(block, receipts)
+	ethReceiptsByHash := make(map[common.Hash]map[string]interface{}, len(ethReceipts))
+	for _, receipt := range ethReceipts {
+		ethReceiptsByHash[receipt["transactionHash"].(common.Hash)] = receipt
+	}
+
+	result := make([]map[string]interface{}, 0, len(block.Block.Txs))
+	for i, txBz := range block.Block.Txs {
+		txResult := block.Results.TxsResults[i]
+		cosmosTxHash := fmt.Sprintf("%X", txBz.Hash())
+
+		tx, err := api.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			// an undecodable tx can only be included as a failed tx, which BuildEthReceipts skips before decoding,
+			// render it without msgs.
+			api.logger.Debug("decoding failed", "error", err.Error())
+			tx = nil
+		}
+
+		if tx != nil && rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) && isEthTx(tx) {
+			for msgIndex, msg := range tx.GetMsgs() {
+				ethTxHash := msg.(*evmtypes.MsgEthereumTx).AsTransaction().Hash()
+				receipt, ok := ethReceiptsByHash[ethTxHash]
+				if !ok {
+					return nil, fmt.Errorf("receipt not found for eth tx: %s", ethTxHash.Hex())
+				}
+				receipt["txType"] = ReceiptTxTypeEthereum
+				receipt["cosmosTxHash"] = cosmosTxHash
+				receipt["cosmosEvents"] = formatEvents(txResult.Events, func(event abci.Event) bool {
+					return isNativeEvent(event) && eventMsgIndex(event) == msgIndex
+				})
+				result = append(result, receipt)
+			}
+			continue
+		}
+
+		result = append(result, api.buildCosmosReceipt(tx, txResult, cosmosTxHash, block.Block.Height, block.Hash, i))
+	}
+	return result, nil
+}
+
+// buildCosmosReceipt renders the result of a non-eth tx in a receipt-like format, tx is nil if it can't be decoded.
+func (api *CronosAPI) buildCosmosReceipt(
+	tx sdk.Tx,
+	txResult *abci.ExecTxResult,
+	cosmosTxHash string,
+	blockNumber int64,
+	blockHash string,
+	txIndex int,
+) map[string]interface{} {
+	var status hexutil.Uint
+	if txResult.Code == abci.CodeTypeOK {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	}
+
+	msgs := []map[string]interface{}{}
+	receipt := map[string]interface{}{
+		"txType":       ReceiptTxTypeCosmos,
+		"cosmosTxHash": cosmosTxHash,
+		"status":       status,
+		"code":         txResult.Code,
+		"codespace":    txResult.Codespace,
+		"gasWanted":    hexutil.Uint64(txResult.GasWanted),
+		"gasUsed":      hexutil.Uint64(txResult.GasUsed),
+		"events":       formatEvents(txResult.Events, nil),
+
+		"blockHash":        blockHash,
+		"blockNumber":      hexutil.Uint64(blockNumber),
+		"transactionIndex": hexutil.Uint64(txIndex),
+	}
+	if tx == nil {
+		receipt["messages"] = msgs
+		return receipt
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		signers := []string{}
+		bzs, _, err := api.clientCtx.Codec.GetMsgV1Signers(msg)
+		if err != nil {
+			api.logger.Debug("failed to get msg signers", "msg", sdk.MsgTypeURL(msg), "error", err.Error())
+		}
+		for _, bz := range bzs {
+			signers = append(signers, sdk.AccAddress(bz).String())
+		}
+		msgs = append(msgs, map[string]interface{}{
+			"type":    sdk.MsgTypeURL(msg),
+			"signers": signers,
+		})
+	}
+	receipt["messages"] = msgs
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		receipt["fee"] = feeTx.GetFee()
+		receipt["feePayer"] = sdk.AccAddress(feeTx.FeePayer()).String()
+		if granter := feeTx.FeeGranter(); len(granter) > 0 {
+			receipt["feeGranter"] = sdk.AccAddress(granter).String()
+		}
+	}
+	return receipt
+}
+
+// isEthTx returns if the tx only contains eth msgs.
+func isEthTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isNativeEvent returns if the event is not one of the events emitted by the evm module to encode the eth tx result.
+func isNativeEvent(event abci.Event) bool {
+	return event.Type != evmtypes.EventTypeEthereumTx && event.Type != evmtypes.EventTypeTxLog
+}
+
+// eventMsgIndex returns the index of the msg which emitted the event, or -1 for the tx level events.
+func eventMsgIndex(event abci.Event) int {
+	for _, attr := range event.Attributes {
+		if attr.Key != msgIndexAttribute {
+			continue
+		}
+		index, err := strconv.Atoi(attr.Value)
+		if err != nil {
+			return -1
+		}
+		return index
+	}
+	return -1
+}
+
+// formatEvents renders the events accepted by the filter as json objects, nil filter accepts all the events.
+func formatEvents(events []abci.Event, filter func(abci.Event) bool) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, event := range events {
+		if filter != nil && !filter(event) {
+			continue
+		}
+		attrs := make([]map[string]string, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs = append(attrs, map[string]string{
+				"key":   attr.Key,
+				"value": attr.Value,
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"type":       event.Type,
+			"attributes": attrs,
+		})
+	}
+	return result
+}
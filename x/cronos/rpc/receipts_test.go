@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/blockquery"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	evmenc "github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGetBlockReceiptsWithCosmosEvents(t *testing.T) {
+	encodingConfig := evmenc.MakeConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	chainID := big.NewInt(777)
+	api := &CronosAPI{
+		clientCtx:    client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec),
+		chainIDEpoch: chainID,
+		logger:       log.NewNopLogger(),
+	}
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	recipient := common.BigToAddress(big.NewInt(1))
+	msg := evmtypes.NewTx(chainID, 0, &recipient, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = sender.Bytes()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+	ethTx, err := msg.BuildTx(encodingConfig.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+	ethTxBz, err := encodingConfig.TxConfig.TxEncoder()(ethTx)
+	require.NoError(t, err)
+	ethTxHash := msg.AsTransaction().Hash()
+
+	builder := encodingConfig.TxConfig.NewTxBuilder()
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(1)))
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(sender, sdk.AccAddress(recipient.Bytes()), coins)))
+	cosmosTxBz, err := encodingConfig.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	transfer := abci.Event{Type: banktypes.EventTypeTransfer, Attributes: []abci.EventAttribute{
+		{Key: banktypes.AttributeKeyRecipient, Value: sdk.AccAddress(recipient.Bytes()).String()},
+		{Key: msgIndexAttribute, Value: "0"},
+	}}
+	txs := []cmttypes.Tx{ethTxBz, cosmosTxBz, []byte("garbage")}
+	resBlock := &coretypes.ResultBlock{Block: &cmttypes.Block{
+		Header: cmttypes.Header{ChainID: "cronos_777-1", Height: 3},
+		Data:   cmttypes.Data{Txs: txs},
+	}}
+	blockRes := &coretypes.ResultBlockResults{Height: 3, TxsResults: []*abci.ExecTxResult{
+		{Code: 0, GasUsed: 21000, Events: []abci.Event{
+			{Type: sdk.EventTypeTx, Attributes: []abci.EventAttribute{{Key: sdk.AttributeKeyFee, Value: "21000basetcro"}}},
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: ethTxHash.Hex()},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+				{Key: msgIndexAttribute, Value: "0"},
+			}},
+			transfer,
+		}},
+		{Code: 0, GasUsed: 50000, Events: []abci.Event{transfer}},
+		// the undecodable tx is included as a failed tx
+		{Code: 2, Codespace: "sdk", GasUsed: 1000},
+	}}
+	block := &blockquery.Block{
+		Block:   resBlock.Block,
+		Results: blockRes,
+		Hash:    common.BytesToHash(resBlock.Block.Hash()).Hex(),
+		BaseFee: big.NewInt(1),
+		Signer:  ethtypes.LatestSignerForChainID(chainID),
+	}
+
+	receipts, err := api.blockReceiptsWithCosmosEvents(block)
+	require.NoError(t, err)
+	require.Len(t, receipts, len(txs))
+
+	// the eth receipt is enriched with the native events of its msg
+	receipt := receipts[0]
+	require.Equal(t, ReceiptTxTypeEthereum, receipt["txType"])
+	require.Equal(t, ethTxHash, receipt["transactionHash"])
+	require.Equal(t, hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+	require.Equal(t, hexutil.Uint64(21000), receipt["gasUsed"])
+	require.Equal(t, formatEvents([]abci.Event{transfer}, nil), receipt["cosmosEvents"])
+
+	receipt = receipts[1]
+	require.Equal(t, ReceiptTxTypeCosmos, receipt["txType"])
+	require.Equal(t, hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+	require.Equal(t, hexutil.Uint64(1), receipt["transactionIndex"])
+	require.Equal(t, []map[string]interface{}{{
+		"type":    sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		"signers": []string{sender.String()},
+	}}, receipt["messages"])
+	require.Equal(t, formatEvents([]abci.Event{transfer}, nil), receipt["events"])
+
+	receipt = receipts[2]
+	require.Equal(t, ReceiptTxTypeCosmos, receipt["txType"])
+	require.Equal(t, hexutil.Uint(ethtypes.ReceiptStatusFailed), receipt["status"])
+	require.Equal(t, uint32(2), receipt["code"])
+	require.Equal(t, []map[string]interface{}{}, receipt["messages"])
+}
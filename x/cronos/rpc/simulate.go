@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// SimulateCallArgs is a call in cronos_simulateBundle, it follows the format of the eth_call args.
+type SimulateCallArgs struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Gas   *hexutil.Uint64 `json:"gas"`
+	Value *hexutil.Big    `json:"value"`
+	Data  *hexutil.Bytes  `json:"data"`
+	Input *hexutil.Bytes  `json:"input"`
+}
+
+// toSimulateCall converts the json-rpc args to the grpc request format, input takes precedence over data.
+func (args SimulateCallArgs) toSimulateCall() types.SimulateCall {
+	call := types.SimulateCall{
+		From:  args.From.Hex(),
+		Value: sdkmath.ZeroInt(),
+	}
+	if args.To != nil {
+		call.To = args.To.Hex()
+	}
+	if args.Gas != nil {
+		call.Gas = uint64(*args.Gas)
+	}
+	if args.Value != nil {
+		call.Value = sdkmath.NewIntFromBigInt(args.Value.ToInt())
+	}
+	if args.Input != nil {
+		call.Data = *args.Input
+	} else if args.Data != nil {
+		call.Data = *args.Data
+	}
+	return call
+}
+
+// SimulateBundle executes the calls in sequence on top of the state of the block with the state overrides,
+// each call sees the state changes of the previous ones. It returns the result, logs, gas used and the native
+// events emitted by the precompiles and the evm log handlers of each call.
+func (api *CronosAPI) SimulateBundle(
+	calls []SimulateCallArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	overrides *types.StateOverride,
+) ([]map[string]interface{}, error) {
+	api.logger.Debug("cronos_simulateBundle", "calls", len(calls), "blockNrOrHash", blockNrOrHash)
+	resBlock, err := api.getBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	req := &types.SimulateBundleRequest{
+		Calls:       make([]types.SimulateCall, 0, len(calls)),
+		BlockNumber: resBlock.Block.Height,
+		BlockHash:   common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		BlockTime:   resBlock.Block.Time,
+	}
+	for _, args := range calls {
+		req.Calls = append(req.Calls, args.toSimulateCall())
+	}
+	if overrides != nil {
+		req.Overrides, err = json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rsp, err := api.cronosQueryClient.SimulateBundle(rpctypes.ContextWithHeight(resBlock.Block.Height), req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]map[string]interface{}, 0, len(rsp.Results))
+	for _, res := range rsp.Results {
+		var status hexutil.Uint
+		if res.VmError != "" {
+			status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+		} else {
+			status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+		}
+		logs := evmtypes.LogsToEthereum(res.Logs)
+		if logs == nil {
+			logs = []*ethtypes.Log{}
+		}
+		results = append(results, map[string]interface{}{
+			"status":       status,
+			"returnData":   hexutil.Bytes(res.Ret),
+			"error":        res.VmError,
+			"gasUsed":      hexutil.Uint64(res.GasUsed),
+			"logs":         logs,
+			"cosmosEvents": formatEvents(res.Events, nil),
+		})
+	}
+	return results, nil
+}
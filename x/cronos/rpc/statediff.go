@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// MaxStateDiffEntries bounds the changed balances and storage slots of each store in cronos_stateDiff.
+	MaxStateDiffEntries = 10000
+	// MaxStateDiffVersions bounds the blocks between fromBlock and toBlock in cronos_stateDiff, the change set
+	// index is read once per block.
+	MaxStateDiffVersions = 10000
+)
+
+// errHistoricalStoreUnavailable is returned by the apis relying on versiondb when it's not enabled.
+var errHistoricalStoreUnavailable = errors.New(
+	"historical store is not available, requires versiondb.enable and versiondb.changeset-index",
+)
+
+// HistoricalStore reads the state at the past versions, it's implemented by versiondb.
+type HistoricalStore interface {
+	GetLatestVersion() (int64, error)
+	GetAtVersion(storeKey string, key []byte, version *int64) ([]byte, error)
+}
+
+var (
+	historicalStoreMu sync.RWMutex
+	historicalStore   HistoricalStore
+	changeSets        ChangeSetReader
+)
+
+// SetHistoricalStore registers versiondb and the index of its change sets, it's set by the app when versiondb is
+// enabled and the json-rpc server runs in the same process.
+func SetHistoricalStore(store HistoricalStore, changeSetReader ChangeSetReader) {
+	historicalStoreMu.Lock()
+	defer historicalStoreMu.Unlock()
+	historicalStore = store
+	changeSets = changeSetReader
+}
+
+// getHistoricalStore returns the registered versiondb and the index of its change sets.
+func getHistoricalStore() (HistoricalStore, ChangeSetReader, error) {
+	historicalStoreMu.RLock()
+	defer historicalStoreMu.RUnlock()
+	if historicalStore == nil || changeSets == nil {
+		return nil, nil, errHistoricalStoreUnavailable
+	}
+	return historicalStore, changeSets, nil
+}
+
+// NonceDiff is the nonce change of cronos_stateDiff.
+type NonceDiff struct {
+	From hexutil.Uint64 `json:"from"`
+	To   hexutil.Uint64 `json:"to"`
+}
+
+// BalanceDiff is the balance change of a denom in cronos_stateDiff, a missing balance is zero.
+type BalanceDiff struct {
+	Denom string       `json:"denom"`
+	From  *hexutil.Big `json:"from"`
+	To    *hexutil.Big `json:"to"`
+}
+
+// StorageDiff is the change of a storage slot in cronos_stateDiff, a missing slot is the zero hash.
+type StorageDiff struct {
+	Key  common.Hash `json:"key"`
+	From common.Hash `json:"from"`
+	To   common.Hash `json:"to"`
+}
+
+// StateDiff is the result of cronos_stateDiff, only the changed fields are listed.
+type StateDiff struct {
+	Address   common.Address `json:"address"`
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+	// Nonce is nil if unchanged.
+	Nonce    *NonceDiff    `json:"nonce"`
+	Balances []BalanceDiff `json:"balances"`
+	Storage  []StorageDiff `json:"storage"`
+}
+
+// StateDiff returns the changes of the account state made by the blocks after fromBlock up to toBlock without
+// replaying the blocks, the changed balances and storage slots are found in the change sets of the versions in
+// between, and read from the versiondb of both heights.
+func (api *CronosAPI) StateDiff(address common.Address, fromBlock, toBlock rpctypes.BlockNumber) (*StateDiff, error) {
+	api.logger.Debug("cronos_stateDiff", "address", address, "fromBlock", fromBlock, "toBlock", toBlock)
+	store, changeSets, err := getHistoricalStore()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := store.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	from, to := resolveVersion(fromBlock, latest), resolveVersion(toBlock, latest)
+	if from > to {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", from, to)
+	}
+	if to > latest {
+		return nil, fmt.Errorf("toBlock %d is after the latest versiondb version %d", to, latest)
+	}
+	if to-from > MaxStateDiffVersions {
+		return nil, fmt.Errorf("too many blocks between fromBlock %d and toBlock %d, max %d", from, to, MaxStateDiffVersions)
+	}
+	// the change sets of all the versions after fromBlock are needed.
+	first, found, err := changeSets.FirstVersion()
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		first = latest + 1
+	}
+	if from < first-1 && from < to {
+		return nil, fmt.Errorf("fromBlock %d is before the first indexed change set %d", from, first)
+	}
+
+	diff := &StateDiff{
+		Address:   address,
+		FromBlock: hexutil.Uint64(from),
+		ToBlock:   hexutil.Uint64(to),
+		Balances:  []BalanceDiff{},
+		Storage:   []StorageDiff{},
+	}
+
+	accAddr := sdk.AccAddress(address.Bytes())
+	fromNonce, err := api.historicalNonce(store, accAddr, from)
+	if err != nil {
+		return nil, err
+	}
+	toNonce, err := api.historicalNonce(store, accAddr, to)
+	if err != nil {
+		return nil, err
+	}
+	if fromNonce != toNonce {
+		diff.Nonce = &NonceDiff{From: hexutil.Uint64(fromNonce), To: hexutil.Uint64(toNonce)}
+	}
+
+	if err := diffBalancesAndStorage(store, changeSets, address, from, to, diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// diffBalancesAndStorage fills the changed bank balances and contract storage of the address in the diff.
+func diffBalancesAndStorage(
+	store HistoricalStore, changeSets ChangeSetReader, address common.Address, from, to int64, diff *StateDiff,
+) error {
+	balancePrefix := banktypes.CreateAccountBalancesPrefix(address.Bytes())
+	err := diffChangedKeys(store, changeSets, banktypes.StoreKey, balancePrefix, from, to, func(key, fromValue, toValue []byte) error {
+		fromAmount, err := decodeBalance(fromValue)
+		if err != nil {
+			return err
+		}
+		toAmount, err := decodeBalance(toValue)
+		if err != nil {
+			return err
+		}
+		diff.Balances = append(diff.Balances, BalanceDiff{
+			Denom: string(key),
+			From:  fromAmount,
+			To:    toAmount,
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	storagePrefix := evmtypes.AddressStoragePrefix(address)
+	return diffChangedKeys(store, changeSets, evmtypes.StoreKey, storagePrefix, from, to, func(key, fromValue, toValue []byte) error {
+		diff.Storage = append(diff.Storage, StorageDiff{
+			Key:  common.BytesToHash(key),
+			From: common.BytesToHash(fromValue),
+			To:   common.BytesToHash(toValue),
+		})
+		return nil
+	})
+}
+
+// resolveVersion converts the block number to the versiondb version, the version of a block is the state after
+// the block, the special block numbers resolve to the latest version except the earliest one.
+func resolveVersion(blockNumber rpctypes.BlockNumber, latest int64) int64 {
+	switch {
+	case blockNumber == rpctypes.EthEarliestBlockNumber:
+		// the version zero is skipped by versiondb.
+		return 1
+	case blockNumber < 0:
+		return latest
+	default:
+		return blockNumber.Int64()
+	}
+}
+
+// historicalNonce returns the sequence of the account at the version, zero if the account doesn't exist.
+func (api *CronosAPI) historicalNonce(store HistoricalStore, addr sdk.AccAddress, version int64) (uint64, error) {
+	bz, err := store.GetAtVersion(authtypes.StoreKey, authtypes.AddressStoreKey(addr), &version)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	var acc sdk.AccountI
+	if err := api.clientCtx.Codec.UnmarshalInterface(bz, &acc); err != nil {
+		return 0, err
+	}
+	return acc.GetSequence(), nil
+}
+
+// diffChangedKeys compares the keys under the prefix written by the change sets between both versions, the
+// callback is called with the key without the prefix for the changed entries in key order, the missing values are
+// nil. A key written back to its original value is not reported.
+func diffChangedKeys(
+	store HistoricalStore, changeSets ChangeSetReader, storeKey string, prefix []byte, from, to int64,
+	cb func(key, fromValue, toValue []byte) error,
+) error {
+	keys, err := changeSets.ChangedKeys(storeKey, prefix, from, to)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		fromValue, err := store.GetAtVersion(storeKey, key, &from)
+		if err != nil {
+			return err
+		}
+		toValue, err := store.GetAtVersion(storeKey, key, &to)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(fromValue, toValue) {
+			continue
+		}
+		if err := cb(key[len(prefix):], fromValue, toValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeBalance decodes the bank balance, a missing balance is zero.
+func decodeBalance(bz []byte) (*hexutil.Big, error) {
+	if len(bz) == 0 {
+		return (*hexutil.Big)(new(big.Int)), nil
+	}
+	amount, err := banktypes.BalanceValueCodec.Decode(bz)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(amount.BigInt()), nil
+}
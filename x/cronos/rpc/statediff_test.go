@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// memHistoricalStore replays the change sets in memory, like versiondb.
+type memHistoricalStore struct {
+	changeSets map[int64][]*storetypes.StoreKVPair
+	latest     int64
+}
+
+func (s *memHistoricalStore) GetLatestVersion() (int64, error) {
+	return s.latest, nil
+}
+
+func (s *memHistoricalStore) GetAtVersion(storeKey string, key []byte, version *int64) ([]byte, error) {
+	var value []byte
+	for v := int64(1); v <= *version; v++ {
+		for _, pair := range s.changeSets[v] {
+			if pair.StoreKey == storeKey && string(pair.Key) == string(key) {
+				value = pair.Value
+				if pair.Delete {
+					value = nil
+				}
+			}
+		}
+	}
+	return value, nil
+}
+
+func balancePair(t *testing.T, address common.Address, denom string, amount int64) *storetypes.StoreKVPair {
+	t.Helper()
+	bz, err := banktypes.BalanceValueCodec.Encode(sdkmath.NewInt(amount))
+	require.NoError(t, err)
+	key := append(banktypes.CreateAccountBalancesPrefix(address.Bytes()), denom...)
+	return &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: key, Value: bz}
+}
+
+func storagePair(address common.Address, slot, value common.Hash) *storetypes.StoreKVPair {
+	key := append(evmtypes.AddressStoragePrefix(address), slot.Bytes()...)
+	return &storetypes.StoreKVPair{StoreKey: evmtypes.StoreKey, Key: key, Value: value.Bytes(), Delete: value == common.Hash{}}
+}
+
+func TestStateDiffChangeSets(t *testing.T) {
+	address := common.BigToAddress(big.NewInt(1))
+	other := common.BigToAddress(big.NewInt(2))
+	slot1, slot2 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+	deleted := balancePair(t, address, "ibc/X", 0)
+	deleted.Delete, deleted.Value = true, nil
+
+	store := &memHistoricalStore{latest: 5, changeSets: map[int64][]*storetypes.StoreKVPair{
+		1: {
+			balancePair(t, address, "basetcro", 100),
+			storagePair(address, slot1, common.BigToHash(big.NewInt(1))),
+			balancePair(t, other, "basetcro", 5),
+		},
+		2: {
+			balancePair(t, address, "basetcro", 150),
+			storagePair(address, slot2, common.BigToHash(big.NewInt(2))),
+			{StoreKey: "acc", Key: address.Bytes(), Value: []byte{1}},
+		},
+		// the ibc balance is written and deleted in between, it's unchanged.
+		3: {storagePair(address, slot1, common.Hash{}), balancePair(t, address, "ibc/X", 7)},
+		4: {deleted, balancePair(t, other, "basetcro", 10)},
+		5: {balancePair(t, address, "basetcro", 175)},
+	}}
+	index := NewChangeSetIndex(dbm.NewMemDB())
+	_, found, err := index.FirstVersion()
+	require.NoError(t, err)
+	require.False(t, found)
+	for v := int64(1); v <= store.latest; v++ {
+		require.NoError(t, index.Record(v, store.changeSets[v]))
+	}
+	first, found, err := index.FirstVersion()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(1), first)
+
+	// the index is read once per version, so the range is bounded
+	_, err = index.ChangedKeys(banktypes.StoreKey, nil, 0, MaxStateDiffVersions+1)
+	require.Error(t, err)
+
+	amount := func(i int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(i)) }
+	testCases := []struct {
+		name     string
+		from, to int64
+		balances []BalanceDiff
+		storage  []StorageDiff
+	}{
+		{
+			"written and reverted",
+			1, 4,
+			[]BalanceDiff{{Denom: "basetcro", From: amount(100), To: amount(150)}},
+			[]StorageDiff{
+				{Key: slot1, From: common.BigToHash(big.NewInt(1)), To: common.Hash{}},
+				{Key: slot2, From: common.Hash{}, To: common.BigToHash(big.NewInt(2))},
+			},
+		},
+		{
+			"single version",
+			4, 5,
+			[]BalanceDiff{{Denom: "basetcro", From: amount(150), To: amount(175)}},
+			[]StorageDiff{},
+		},
+		{
+			"from the empty state",
+			0, 1,
+			[]BalanceDiff{{Denom: "basetcro", From: amount(0), To: amount(100)}},
+			[]StorageDiff{{Key: slot1, From: common.Hash{}, To: common.BigToHash(big.NewInt(1))}},
+		},
+		{
+			"same version",
+			3, 3,
+			[]BalanceDiff{},
+			[]StorageDiff{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := &StateDiff{Balances: []BalanceDiff{}, Storage: []StorageDiff{}}
+			require.NoError(t, diffBalancesAndStorage(store, index, address, tc.from, tc.to, diff))
+			require.Equal(t, tc.balances, diff.Balances)
+			require.Equal(t, tc.storage, diff.Storage)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	icatypes "github.com/cosmos/ibc-go/v11/modules/apps/27-interchain-accounts/types"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v11/modules/apps/callbacks/types"
+	"github.com/crypto-org-chain/cronos/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/evmos/ethermint/rpc/stream"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	evictionStreamSegmentSize = 1024
+	evictionStreamCapacity    = evictionStreamSegmentSize * 16
+)
+
+// mempoolEvictions buffers the evictions of the app-side mempool for the mempoolEvictions subscribers,
+// it's fed by NotifyMempoolEviction when the json-rpc server runs in the same process as the app.
+var mempoolEvictions = stream.NewStream[MempoolEviction](evictionStreamSegmentSize, evictionStreamCapacity)
+
+// CronosEvent is the notification of the cronos_subscribe feeds backed by the block events.
+type CronosEvent struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	// CosmosTxHash is empty for the events emitted outside of the txs, e.g. by the gov proposals or the end blocker.
+	CosmosTxHash string                 `json:"cosmosTxHash,omitempty"`
+	Event        map[string]interface{} `json:"event"`
+}
+
+// MempoolEviction is the notification of the mempoolEvictions feed.
+type MempoolEviction struct {
+	EthTxHashes []common.Hash `json:"ethTxHashes,omitempty"`
+	Senders     []string      `json:"senders"`
+	Reason      string        `json:"reason"`
+}
+
+// NotifyMempoolEviction publishes a tx evicted from the app-side mempool to the mempoolEvictions subscribers,
+// it's meant to be set as the eviction listener of the app mempool, so it never blocks.
+func NotifyMempoolEviction(tx sdk.Tx, signers []string, reason string) {
+	eviction := MempoolEviction{
+		Senders: signers,
+		Reason:  reason,
+	}
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		if ethTx := ethMsg.AsTransaction(); ethTx != nil {
+			eviction.EthTxHashes = append(eviction.EthTxHashes, ethTx.Hash())
+		}
+	}
+	mempoolEvictions.Add(eviction)
+}
+
+// BlocklistUpdates subscribes to the block list updates stored by the admin, the list itself is not revealed.
+func (api *CronosAPI) BlocklistUpdates(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeEvents(ctx, eventTypeFilter(types.EventTypeBlockListUpdated))
+}
+
+// TokenMappingUpdates subscribes to the changes of the denom to contract mappings.
+func (api *CronosAPI) TokenMappingUpdates(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeEvents(ctx, eventTypeFilter(types.EventTypeTokenMappingUpdated))
+}
+
+// IbcConversions subscribes to the conversion results of the received or refunded ibc vouchers,
+// including the retries of the failed refund conversions.
+func (api *CronosAPI) IbcConversions(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeEvents(ctx, eventTypeFilter(
+		types.EventTypeIbcConversion,
+		types.EventTypeRefundConversionFailed,
+		types.EventTypeRefundConversionRetried,
+	))
+}
+
+// IcaCallbacks subscribes to the results of the packet callbacks of the interchain account controllers.
+func (api *CronosAPI) IcaCallbacks(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeEvents(ctx, func(event abci.Event) bool {
+		if event.Type != ibccallbackstypes.EventTypeSourceCallback {
+			return false
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == ibccallbackstypes.AttributeKeyCallbackSourcePortID {
+				return strings.HasPrefix(attr.Value, icatypes.ControllerPortPrefix)
+			}
+		}
+		return false
+	})
+}
+
+// MempoolEvictions subscribes to the txs evicted from the app-side mempool with the reason,
+// only available when the node runs with mempool.type=app.
+func (api *CronosAPI) MempoolEvictions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer cancel()
+		_ = mempoolEvictions.Subscribe(subCtx, func(evictions []MempoolEviction, _ int) error {
+			for _, eviction := range evictions {
+				if err := notifier.Notify(rpcSub.ID, eviction); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}()
+	go unsubscribeOnError(subCtx, rpcSub, cancel)
+	return rpcSub, nil
+}
+
+// subscribeEvents notifies the block events accepted by the filter, the blocks are followed with the header stream.
+func (api *CronosAPI) subscribeEvents(ctx context.Context, filter func(abci.Event) bool) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	if api.evtStream == nil {
+		return &rpc.Subscription{}, errors.New("event stream is not available")
+	}
+	rpcSub := notifier.CreateSubscription()
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer cancel()
+		_ = api.evtStream.HeaderStream().Subscribe(subCtx, func(headers []stream.RPCHeader, _ int) error {
+			for _, header := range headers {
+				height := header.EthHeader.Number.Int64()
+				events, err := api.blockEvents(height, header.Hash, filter)
+				if err != nil {
+					api.logger.Error("failed to fetch block events", "height", height, "error", err.Error())
+					continue
+				}
+				for _, event := range events {
+					if err := notifier.Notify(rpcSub.ID, event); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+	}()
+	go unsubscribeOnError(subCtx, rpcSub, cancel)
+	return rpcSub, nil
+}
+
+// blockEvents returns the events of the block accepted by the filter, the tx events come first in block order.
+func (api *CronosAPI) blockEvents(height int64, blockHash common.Hash, filter func(abci.Event) bool) ([]CronosEvent, error) {
+	blockRes, err := api.backend.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result []CronosEvent
+		// only fetched when a tx event is matched, to compute the tx hashes.
+		resBlock *coretypes.ResultBlock
+	)
+	for i, txResult := range blockRes.TxsResults {
+		events := formatEvents(txResult.Events, filter)
+		if len(events) == 0 {
+			continue
+		}
+		if resBlock == nil {
+			resBlock, err = api.backend.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil || len(resBlock.Block.Txs) != len(blockRes.TxsResults) {
+				return nil, fmt.Errorf("block not found or inconsistent with the block results: %d", height)
+			}
+		}
+		txHash := fmt.Sprintf("%X", resBlock.Block.Txs[i].Hash())
+		for _, event := range events {
+			result = append(result, CronosEvent{
+				BlockNumber:  hexutil.Uint64(height),
+				BlockHash:    blockHash,
+				CosmosTxHash: txHash,
+				Event:        event,
+			})
+		}
+	}
+	for _, event := range formatEvents(blockRes.FinalizeBlockEvents, filter) {
+		result = append(result, CronosEvent{
+			BlockNumber: hexutil.Uint64(height),
+			BlockHash:   blockHash,
+			Event:       event,
+		})
+	}
+	return result, nil
+}
+
+// eventTypeFilter accepts the events of the given types.
+func eventTypeFilter(eventTypes ...string) func(abci.Event) bool {
+	return func(event abci.Event) bool {
+		for _, eventType := range eventTypes {
+			if event.Type == eventType {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// unsubscribeOnError stops the subscription when the client unsubscribes or the connection is closed.
+func unsubscribeOnError(ctx context.Context, rpcSub *rpc.Subscription, cancel context.CancelFunc) {
+	select {
+	case <-rpcSub.Err():
+		cancel()
+	case <-ctx.Done():
+	}
+}
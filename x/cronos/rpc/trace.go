@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ReplayTraceConfig is the trace config of cronos_traceReplayBlock, it extends the debug_traceBlock config
+// with the rule to replay the tx that exceeded the block gas limit.
+type ReplayTraceConfig struct {
+	rpctypes.TraceConfig
+	// PostUpgrade treats the tx that exceeded block gas limit as reverted, otherwise as committed.
+	PostUpgrade bool `json:"postUpgrade"`
+}
+
+// TraceReplayBlock traces all the eth transactions in the block by replaying them like cronos_replayBlock,
+// the tracers supported by debug_traceBlock are available, the struct logger is used if no tracer is specified.
+func (api *CronosAPI) TraceReplayBlock(blockNrOrHash rpctypes.BlockNumberOrHash, config *ReplayTraceConfig) ([]*evmtypes.TxTraceResult, error) {
+	api.logger.Debug("cronos_traceReplayBlock", "blockNrOrHash", blockNrOrHash)
+	replayCtx, err := api.prepareReplayBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*evmtypes.TxTraceResult, 0)
+	if replayCtx.req == nil {
+		return results, nil
+	}
+
+	traceConfig := &evmtypes.TraceConfig{}
+	postUpgrade := false
+	if config != nil {
+		traceConfig = &config.TraceConfig.TraceConfig
+		if config.TracerConfig != nil {
+			traceConfig.TracerJsonConfig = string(config.TracerConfig)
+		}
+		postUpgrade = config.PostUpgrade
+	}
+	replayCtx.req.TraceConfig = traceConfig
+
+	// after the 0.7.0 upgrade, the tx that exceeded block gas limit is reverted without execution,
+	// so there's nothing to trace.
+	revertLast := replayCtx.blockGasLimitExceeded && postUpgrade
+	if revertLast {
+		replayCtx.req.Msgs = replayCtx.req.Msgs[:len(replayCtx.req.Msgs)-1]
+	}
+
+	if len(replayCtx.req.Msgs) > 0 {
+		rsp, err := api.cronosQueryClient.ReplayBlock(rpctypes.ContextWithHeight(replayCtx.contextHeight()), replayCtx.req)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range rsp.Traces {
+			results = append(results, &evmtypes.TxTraceResult{Result: json.RawMessage(trace)})
+		}
+	}
+
+	if revertLast {
+		results = append(results, &evmtypes.TxTraceResult{Error: ExceedBlockGasLimitError})
+	}
+	return results, nil
+}
@@ -18,6 +18,7 @@ const (
 	cronosAdminKey          = "cronos_admin"
 	enableAutoDeploymentKey = "enable_auto_deployment"
 	maxCallbackGasKey       = "max_callback_gas"
+	maxRefundRetryGasKey    = "max_refund_retry_gas"
 )
 
 func GenIbcCroDenom(r *rand.Rand) string {
@@ -45,6 +46,10 @@ func GenMaxCallbackGas(r *rand.Rand) uint64 {
 	return maxCallbackGas
 }
 
+func GenMaxRefundRetryGas(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(int64(10 * types.MaxRefundRetryGasDefaultValue)))
+}
+
 // RandomizedGenState generates a random GenesisState for the cronos module
 func RandomizedGenState(simState *module.SimulationState) {
 	// cronos params
@@ -54,6 +59,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		cronosAdmin          string
 		enableAutoDeployment bool
 		maxCallbackGas       uint64
+		maxRefundRetryGas    uint64
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -81,7 +87,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxCallbackGas = GenMaxCallbackGas(r) },
 	)
 
-	params := types.NewParams(ibcCroDenom, ibcTimeout, cronosAdmin, enableAutoDeployment, maxCallbackGas, []string{})
+	simState.AppParams.GetOrGenerate(
+		maxRefundRetryGasKey, &maxRefundRetryGas, simState.Rand,
+		func(r *rand.Rand) { maxRefundRetryGas = GenMaxRefundRetryGas(r) },
+	)
+
+	params := types.NewParams(ibcCroDenom, ibcTimeout, cronosAdmin, enableAutoDeployment, maxCallbackGas, []string{}, maxRefundRetryGas, []types.DenomScaling{}, []types.LogHandlerPolicy{}, false)
 	cronosGenesis := &types.GenesisState{
 		Params:            params,
 		ExternalContracts: nil,
@@ -26,6 +26,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateTokenMapping{},
 		&MsgTurnBridge{},
 		&MsgUpdatePermissions{},
+		&MsgClaimDust{},
+		&MsgSetLogAction{},
+		&MsgDeleteLogAction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -34,6 +36,16 @@ type Params struct {
 	MaxCallbackGas       uint64 `protobuf:"varint,5,opt,name=max_callback_gas,json=maxCallbackGas,proto3" json:"max_callback_gas,omitempty"`
 	// the authorized contract addresses for the SendCroToIbc hook; empty list disables the hook
 	CroBridgeContractAddresses []string `protobuf:"bytes,6,rep,name=cro_bridge_contract_addresses,json=croBridgeContractAddresses,proto3" json:"cro_bridge_contract_addresses,omitempty"`
+	// the gas budget spent on retrying failed refund conversions in each EndBlock; zero disables the retries
+	MaxRefundRetryGas uint64 `protobuf:"varint,7,opt,name=max_refund_retry_gas,json=maxRefundRetryGas,proto3" json:"max_refund_retry_gas,omitempty"`
+	// the denoms converted to a denom with more decimals like ibc_cro_denom, ibc_cro_denom is
+	// converted to the evm denom with a 10^10 factor when it has no entry
+	DenomScalings []DenomScaling `protobuf:"bytes,8,rep,name=denom_scalings,json=denomScalings,proto3" json:"denom_scalings"`
+	// the restrictions of the contracts which can trigger the evm log handlers
+	LogHandlerPolicies []LogHandlerPolicy `protobuf:"bytes,9,rep,name=log_handler_policies,json=logHandlerPolicies,proto3" json:"log_handler_policies"`
+	// revert the tx when an evm log handler can't decode a log, instead of adding a __CronosHandlerError log
+	// to the receipt
+	StrictLogHandlers bool `protobuf:"varint,10,opt,name=strict_log_handlers,json=strictLogHandlers,proto3" json:"strict_log_handlers,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -110,6 +122,154 @@ func (m *Params) GetCroBridgeContractAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetMaxRefundRetryGas() uint64 {
+	if m != nil {
+		return m.MaxRefundRetryGas
+	}
+	return 0
+}
+
+func (m *Params) GetDenomScalings() []DenomScaling {
+	if m != nil {
+		return m.DenomScalings
+	}
+	return nil
+}
+
+func (m *Params) GetLogHandlerPolicies() []LogHandlerPolicy {
+	if m != nil {
+		return m.LogHandlerPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetStrictLogHandlers() bool {
+	if m != nil {
+		return m.StrictLogHandlers
+	}
+	return false
+}
+
+// LogHandlerPolicy restricts the contracts which can trigger an evm log handler.
+type LogHandlerPolicy struct {
+	// the event name of the log handler, like "__CronosSendToIbc"
+	EventName string `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// the hex addresses of the contracts allowed to trigger the handler, empty allows all of them
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// the hex addresses of the contracts not allowed to trigger the handler, it takes precedence over the allowlist
+	DeniedContracts []string `protobuf:"bytes,3,rep,name=denied_contracts,json=deniedContracts,proto3" json:"denied_contracts,omitempty"`
+	// the maximum amount handled for a contract in a block, zero means unlimited
+	MaxAmountPerBlock cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_amount_per_block,json=maxAmountPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_per_block"`
+}
+
+func (m *LogHandlerPolicy) Reset()         { *m = LogHandlerPolicy{} }
+func (m *LogHandlerPolicy) String() string { return proto.CompactTextString(m) }
+func (*LogHandlerPolicy) ProtoMessage()    {}
+func (*LogHandlerPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{1}
+}
+func (m *LogHandlerPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogHandlerPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogHandlerPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogHandlerPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogHandlerPolicy.Merge(m, src)
+}
+func (m *LogHandlerPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogHandlerPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogHandlerPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogHandlerPolicy proto.InternalMessageInfo
+
+func (m *LogHandlerPolicy) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *LogHandlerPolicy) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *LogHandlerPolicy) GetDeniedContracts() []string {
+	if m != nil {
+		return m.DeniedContracts
+	}
+	return nil
+}
+
+// DenomScaling defines the conversion of a denom to a target denom with more decimals,
+// the decimals of both denoms are read from the bank metadata.
+type DenomScaling struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TargetDenom string `protobuf:"bytes,2,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+}
+
+func (m *DenomScaling) Reset()         { *m = DenomScaling{} }
+func (m *DenomScaling) String() string { return proto.CompactTextString(m) }
+func (*DenomScaling) ProtoMessage()    {}
+func (*DenomScaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{2}
+}
+func (m *DenomScaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomScaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomScaling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomScaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomScaling.Merge(m, src)
+}
+func (m *DenomScaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomScaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomScaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomScaling proto.InternalMessageInfo
+
+func (m *DenomScaling) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomScaling) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
+	}
+	return ""
+}
+
 // TokenMappingChangeProposal defines a proposal to change one token mapping.
 type TokenMappingChangeProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -124,7 +284,7 @@ type TokenMappingChangeProposal struct {
 func (m *TokenMappingChangeProposal) Reset()      { *m = TokenMappingChangeProposal{} }
 func (*TokenMappingChangeProposal) ProtoMessage() {}
 func (*TokenMappingChangeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{1}
+	return fileDescriptor_8bc54992a93db2d2, []int{3}
 }
 func (m *TokenMappingChangeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +323,7 @@ func (m *TokenMapping) Reset()         { *m = TokenMapping{} }
 func (m *TokenMapping) String() string { return proto.CompactTextString(m) }
 func (*TokenMapping) ProtoMessage()    {}
 func (*TokenMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bc54992a93db2d2, []int{2}
+	return fileDescriptor_8bc54992a93db2d2, []int{4}
 }
 func (m *TokenMapping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,47 +366,284 @@ func (m *TokenMapping) GetContract() string {
 	return ""
 }
 
+// PendingRefundConversion records an IBC refund whose conversion back to the
+// evm representation failed, the refunded vouchers are kept by the sender until
+// the conversion is retried successfully.
+type PendingRefundConversion struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// the number of failed retries so far
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the block height from which the conversion is retried again
+	NextRetryHeight int64 `protobuf:"varint,4,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	// the error of the last failed conversion
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *PendingRefundConversion) Reset()         { *m = PendingRefundConversion{} }
+func (m *PendingRefundConversion) String() string { return proto.CompactTextString(m) }
+func (*PendingRefundConversion) ProtoMessage()    {}
+func (*PendingRefundConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{5}
+}
+func (m *PendingRefundConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRefundConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRefundConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRefundConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRefundConversion.Merge(m, src)
+}
+func (m *PendingRefundConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRefundConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRefundConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRefundConversion proto.InternalMessageInfo
+
+func (m *PendingRefundConversion) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingRefundConversion) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PendingRefundConversion) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PendingRefundConversion) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func (m *PendingRefundConversion) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+// DustBalance records the remainder of an address which is too small to be
+// converted back from the target denom of a DenomScaling, it's held by the
+// module account until it's used by a later transfer or claimed back.
+type DustBalance struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DustBalance) Reset()         { *m = DustBalance{} }
+func (m *DustBalance) String() string { return proto.CompactTextString(m) }
+func (*DustBalance) ProtoMessage()    {}
+func (*DustBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{6}
+}
+func (m *DustBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DustBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DustBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DustBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DustBalance.Merge(m, src)
+}
+func (m *DustBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DustBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DustBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DustBalance proto.InternalMessageInfo
+
+func (m *DustBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DustBalance) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// LogAction binds the logs of an event emitted by a contract to a whitelisted native message,
+// the event arguments are decoded with the abi and substituted into the message template.
+type LogAction struct {
+	// the hex address of the contract emitting the event
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the json abi of the event
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// the json encoded native message with its "@type", the string values "$<argument name>" are replaced
+	// with the event arguments, "$contract" with the contract account and "$denom" with the denom mapped
+	// to the contract
+	MsgTemplate string `protobuf:"bytes,3,opt,name=msg_template,json=msgTemplate,proto3" json:"msg_template,omitempty"`
+}
+
+func (m *LogAction) Reset()         { *m = LogAction{} }
+func (m *LogAction) String() string { return proto.CompactTextString(m) }
+func (*LogAction) ProtoMessage()    {}
+func (*LogAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{7}
+}
+func (m *LogAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogAction.Merge(m, src)
+}
+func (m *LogAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogAction proto.InternalMessageInfo
+
+func (m *LogAction) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *LogAction) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *LogAction) GetMsgTemplate() string {
+	if m != nil {
+		return m.MsgTemplate
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cronos.Params")
+	proto.RegisterType((*LogHandlerPolicy)(nil), "cronos.LogHandlerPolicy")
+	proto.RegisterType((*DenomScaling)(nil), "cronos.DenomScaling")
 	proto.RegisterType((*TokenMappingChangeProposal)(nil), "cronos.TokenMappingChangeProposal")
 	proto.RegisterType((*TokenMapping)(nil), "cronos.TokenMapping")
+	proto.RegisterType((*PendingRefundConversion)(nil), "cronos.PendingRefundConversion")
+	proto.RegisterType((*DustBalance)(nil), "cronos.DustBalance")
+	proto.RegisterType((*LogAction)(nil), "cronos.LogAction")
 }
 
 func init() { proto.RegisterFile("cronos/cronos.proto", fileDescriptor_8bc54992a93db2d2) }
 
 var fileDescriptor_8bc54992a93db2d2 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xb1, 0x6e, 0xdb, 0x3c,
-	0x18, 0xb4, 0x1c, 0x47, 0xbf, 0x4d, 0x27, 0x3f, 0x0a, 0xd6, 0x08, 0x04, 0x03, 0x95, 0x55, 0x4d,
-	0x1a, 0x9a, 0x18, 0x45, 0x3b, 0x79, 0xaa, 0xed, 0xa0, 0x1d, 0x8a, 0x02, 0x01, 0x91, 0xa9, 0x8b,
-	0x40, 0x51, 0x84, 0x4c, 0x44, 0xe4, 0x27, 0x90, 0x34, 0x60, 0xbd, 0x41, 0xc7, 0x8e, 0x1d, 0xf3,
-	0x22, 0x5d, 0x3a, 0x75, 0xcc, 0xd8, 0xa9, 0x28, 0xec, 0x37, 0xe8, 0x13, 0x14, 0x12, 0xed, 0x34,
-	0x19, 0x3a, 0x89, 0x77, 0xf7, 0x09, 0xc7, 0x3b, 0x7e, 0xe8, 0x29, 0xd3, 0xa0, 0xc0, 0x4c, 0xdd,
-	0xe7, 0xa2, 0xd2, 0x60, 0x01, 0xfb, 0x0e, 0x8d, 0x47, 0x05, 0x14, 0xd0, 0x52, 0xd3, 0xe6, 0xe4,
-	0xd4, 0xf8, 0x6b, 0x17, 0xf9, 0x57, 0x54, 0x53, 0x69, 0xf0, 0x5b, 0x74, 0x2a, 0x32, 0x96, 0x32,
-	0x0d, 0x69, 0xce, 0x15, 0xc8, 0xc0, 0x8b, 0xbc, 0x64, 0xb0, 0x88, 0x7f, 0xff, 0x9c, 0x84, 0x35,
-	0x95, 0xe5, 0x2c, 0x7e, 0x24, 0xbf, 0x00, 0x29, 0x2c, 0x97, 0x95, 0xad, 0x63, 0x32, 0x14, 0x19,
-	0x5b, 0x6a, 0xb8, 0x6c, 0x78, 0x3c, 0x41, 0x0d, 0x4c, 0xad, 0x90, 0x1c, 0xd6, 0x36, 0xe8, 0x46,
-	0x5e, 0xd2, 0x23, 0x48, 0x64, 0xec, 0xda, 0x31, 0xf8, 0x39, 0x3a, 0x71, 0x77, 0x4a, 0x69, 0x2e,
-	0x85, 0x0a, 0x8e, 0x1a, 0x1f, 0x32, 0x74, 0xdc, 0xbc, 0xa1, 0xf0, 0x6b, 0x74, 0xc6, 0x15, 0xcd,
-	0x4a, 0x9e, 0xd2, 0xb5, 0x6d, 0x0c, 0xab, 0x12, 0x6a, 0xc9, 0x95, 0x0d, 0x7a, 0x91, 0x97, 0xf4,
-	0xc9, 0xc8, 0xa9, 0xf3, 0xb5, 0x85, 0xcb, 0x7b, 0x0d, 0x27, 0xe8, 0x89, 0xa4, 0x9b, 0x94, 0xd1,
-	0xb2, 0xcc, 0x28, 0xbb, 0x49, 0x0b, 0x6a, 0x82, 0xe3, 0xd6, 0xfe, 0x7f, 0x49, 0x37, 0xcb, 0x3d,
-	0xfd, 0x8e, 0x1a, 0x3c, 0x47, 0xcf, 0x9a, 0x20, 0x99, 0x16, 0x79, 0xc1, 0x53, 0x06, 0xca, 0x6a,
-	0xca, 0x6c, 0x4a, 0xf3, 0x5c, 0x73, 0x63, 0xb8, 0x09, 0xfc, 0xe8, 0x28, 0x19, 0x90, 0x31, 0xd3,
-	0xb0, 0x68, 0x67, 0x96, 0xfb, 0x91, 0xf9, 0x61, 0x62, 0xd6, 0xfb, 0x72, 0x3b, 0xe9, 0xc4, 0xdf,
-	0x3c, 0x34, 0xbe, 0x86, 0x1b, 0xae, 0x3e, 0xd0, 0xaa, 0x12, 0xaa, 0x58, 0xae, 0xa8, 0x2a, 0xf8,
-	0x95, 0x86, 0x0a, 0x0c, 0x2d, 0xf1, 0x08, 0x1d, 0x5b, 0x61, 0x4b, 0xee, 0xba, 0x24, 0x0e, 0xe0,
-	0x08, 0x0d, 0x73, 0x6e, 0x98, 0x16, 0x95, 0x15, 0xa0, 0xda, 0x86, 0x06, 0xe4, 0x21, 0xd5, 0xfc,
-	0xe7, 0xde, 0xc0, 0x75, 0xe3, 0x00, 0x1e, 0xa3, 0xfe, 0xe1, 0xaa, 0x6d, 0x0f, 0x03, 0x72, 0x8f,
-	0xf1, 0x19, 0xf2, 0x4d, 0x2d, 0x33, 0x28, 0xdb, 0xc4, 0x03, 0xb2, 0x47, 0x38, 0x40, 0xff, 0xe5,
-	0x9c, 0x09, 0x49, 0xcb, 0xc0, 0x8f, 0xbc, 0xe4, 0x94, 0x1c, 0xe0, 0xac, 0xff, 0xe9, 0x76, 0xd2,
-	0x69, 0x43, 0xbc, 0x41, 0x27, 0x0f, 0x33, 0xfc, 0x75, 0xf7, 0xfe, 0xe5, 0xde, 0x7d, 0xec, 0xbe,
-	0x78, 0xff, 0x7d, 0x1b, 0x7a, 0x77, 0xdb, 0xd0, 0xfb, 0xb5, 0x0d, 0xbd, 0xcf, 0xbb, 0xb0, 0x73,
-	0xb7, 0x0b, 0x3b, 0x3f, 0x76, 0x61, 0xe7, 0xe3, 0xcb, 0x42, 0xd8, 0xd5, 0x3a, 0xbb, 0x60, 0x20,
-	0xa7, 0x4c, 0xd7, 0x95, 0x85, 0x73, 0xd0, 0xc5, 0x39, 0x5b, 0x51, 0xa1, 0xf6, 0x8b, 0x3a, 0xdd,
-	0x1c, 0x0e, 0xb6, 0xae, 0xb8, 0xc9, 0xfc, 0x76, 0x35, 0x5f, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0x88, 0x69, 0xed, 0xce, 0xcf, 0x02, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xd6, 0x5a, 0xb6, 0x62, 0x51, 0x76, 0x62, 0x33, 0x6a, 0xba, 0x15, 0x60, 0x49, 0xdd, 0x93,
+	0xfa, 0x93, 0x15, 0x9c, 0x16, 0x28, 0xe0, 0x53, 0x25, 0xb9, 0x4d, 0x8a, 0xa6, 0x81, 0xb0, 0xf5,
+	0xa9, 0x97, 0x0d, 0x97, 0xcb, 0xae, 0x08, 0xf3, 0x67, 0x41, 0x52, 0xae, 0xf4, 0x06, 0x3d, 0xf6,
+	0xd8, 0x63, 0xde, 0xa1, 0x6f, 0xd0, 0x53, 0x8e, 0xe9, 0xad, 0xed, 0xc1, 0x28, 0xec, 0x37, 0xe8,
+	0x13, 0x14, 0x24, 0x77, 0x6d, 0x39, 0x40, 0x2f, 0x39, 0x49, 0xf3, 0x7d, 0xc3, 0x19, 0xce, 0x37,
+	0x33, 0x5c, 0xf0, 0x10, 0x2b, 0x29, 0xa4, 0x1e, 0xfb, 0x9f, 0xb8, 0x54, 0xd2, 0x48, 0xd8, 0xf2,
+	0x56, 0xaf, 0x5b, 0xc8, 0x42, 0x3a, 0x68, 0x6c, 0xff, 0x79, 0xb6, 0xd7, 0xc7, 0x52, 0x73, 0xa9,
+	0xc7, 0x19, 0xd2, 0x64, 0x7c, 0x71, 0x9c, 0x11, 0x83, 0x8e, 0xc7, 0x58, 0x52, 0xe1, 0xf9, 0xe8,
+	0xb7, 0x6d, 0xd0, 0x9a, 0x23, 0x85, 0xb8, 0x86, 0x5f, 0x83, 0x7d, 0x9a, 0xe1, 0x14, 0x2b, 0x99,
+	0xe6, 0x44, 0x48, 0x1e, 0x06, 0xc3, 0x60, 0xd4, 0x9e, 0x46, 0xff, 0x5e, 0x0e, 0xfa, 0x6b, 0xc4,
+	0xd9, 0x49, 0x74, 0x87, 0xfe, 0x54, 0x72, 0x6a, 0x08, 0x2f, 0xcd, 0x3a, 0x4a, 0x3a, 0x34, 0xc3,
+	0x33, 0x25, 0x4f, 0x2d, 0x0e, 0x07, 0xc0, 0x9a, 0xa9, 0xa1, 0x9c, 0xc8, 0xa5, 0x09, 0xb7, 0x86,
+	0xc1, 0x68, 0x3b, 0x01, 0x34, 0xc3, 0x67, 0x1e, 0x81, 0x1f, 0x82, 0x3d, 0x7f, 0xe7, 0x14, 0xe5,
+	0x9c, 0x8a, 0xb0, 0x69, 0xf3, 0x24, 0x1d, 0x8f, 0x4d, 0x2c, 0x04, 0x3f, 0x07, 0x8f, 0x88, 0x40,
+	0x19, 0x23, 0x29, 0x5a, 0x1a, 0x9b, 0xb0, 0x64, 0x72, 0xcd, 0x89, 0x30, 0xe1, 0xf6, 0x30, 0x18,
+	0xed, 0x26, 0x5d, 0xcf, 0x4e, 0x96, 0x46, 0x9e, 0xde, 0x70, 0x70, 0x04, 0x0e, 0x38, 0x5a, 0xa5,
+	0x18, 0x31, 0x96, 0x21, 0x7c, 0x9e, 0x16, 0x48, 0x87, 0x3b, 0x2e, 0xfd, 0x7d, 0x8e, 0x56, 0xb3,
+	0x0a, 0x7e, 0x8a, 0x34, 0x9c, 0x80, 0x23, 0x5b, 0x48, 0xa6, 0x68, 0x5e, 0x90, 0x14, 0x4b, 0x61,
+	0x14, 0xc2, 0x26, 0x45, 0x79, 0xae, 0x88, 0xd6, 0x44, 0x87, 0xad, 0x61, 0x73, 0xd4, 0x4e, 0x7a,
+	0x58, 0xc9, 0xa9, 0xf3, 0x99, 0x55, 0x2e, 0x93, 0xda, 0x03, 0x8e, 0x41, 0xd7, 0x26, 0x53, 0xe4,
+	0xc7, 0xa5, 0xc8, 0x53, 0x45, 0x8c, 0x5a, 0xbb, 0x84, 0xf7, 0x5c, 0xc2, 0x43, 0x8e, 0x56, 0x89,
+	0xa3, 0x12, 0xcb, 0xf8, 0x9c, 0xf7, 0x9d, 0x70, 0xa9, 0xc6, 0x88, 0x51, 0x51, 0xe8, 0x70, 0x77,
+	0xd8, 0x1c, 0x75, 0x9e, 0x74, 0xe3, 0xaa, 0x9f, 0x4e, 0xbe, 0xef, 0x3d, 0x39, 0xdd, 0x7e, 0x7d,
+	0x39, 0x68, 0x24, 0xfb, 0xf9, 0x06, 0xa6, 0xe1, 0x1c, 0x74, 0x99, 0x2c, 0xd2, 0x05, 0x12, 0x39,
+	0x23, 0x2a, 0x2d, 0x25, 0xa3, 0x98, 0x12, 0x1d, 0xb6, 0x5d, 0xa0, 0xb0, 0x0e, 0xf4, 0x5c, 0x16,
+	0xcf, 0xbc, 0xcb, 0xdc, 0x7a, 0xac, 0xab, 0x60, 0x90, 0xdd, 0xc5, 0x29, 0xd1, 0x30, 0x06, 0x0f,
+	0xb5, 0x51, 0x14, 0x9b, 0x74, 0x23, 0xb0, 0x0e, 0x81, 0x53, 0xf9, 0xd0, 0x53, 0xb7, 0xe1, 0xf4,
+	0xc9, 0xf6, 0xaf, 0xaf, 0x06, 0x8d, 0xe8, 0xaf, 0x00, 0x1c, 0xbc, 0x9d, 0x04, 0x1e, 0x01, 0x40,
+	0x2e, 0x88, 0x30, 0xa9, 0x40, 0x9c, 0xf8, 0xe1, 0x49, 0xda, 0x0e, 0x79, 0x81, 0x38, 0x81, 0x9f,
+	0x80, 0x43, 0xc4, 0x98, 0xfc, 0x89, 0xe4, 0x37, 0x7a, 0xeb, 0x70, 0xcb, 0xc9, 0x7c, 0x50, 0x11,
+	0xb5, 0xc8, 0x1a, 0x7e, 0x04, 0x0e, 0x72, 0x22, 0xe8, 0x1d, 0xdf, 0xa6, 0xf3, 0x7d, 0xe0, 0xf1,
+	0x5b, 0xd7, 0x17, 0xbe, 0x0f, 0x88, 0xcb, 0xa5, 0x30, 0x69, 0x49, 0x54, 0x9a, 0x31, 0x89, 0xcf,
+	0xdd, 0xa0, 0xb4, 0xa7, 0x47, 0xb6, 0xf2, 0xbf, 0x2f, 0x07, 0xef, 0xf9, 0x3d, 0xd0, 0xf9, 0x79,
+	0x4c, 0xe5, 0x98, 0x23, 0xb3, 0x88, 0xbf, 0x11, 0xc6, 0xb5, 0x69, 0xe2, 0x4e, 0xce, 0x89, 0x9a,
+	0xda, 0x73, 0xd1, 0x53, 0xb0, 0xb7, 0xd9, 0x08, 0xd8, 0x05, 0x3b, 0x1b, 0xeb, 0x90, 0x78, 0xc3,
+	0xce, 0xb0, 0x41, 0xaa, 0x20, 0xa6, 0xda, 0x95, 0x2d, 0x3f, 0xc3, 0x1e, 0x73, 0xe7, 0xa3, 0xdf,
+	0x03, 0xd0, 0x3b, 0x93, 0xe7, 0x44, 0x7c, 0x87, 0xca, 0x92, 0x8a, 0x62, 0xb6, 0x40, 0xa2, 0x20,
+	0x73, 0x25, 0x4b, 0xa9, 0x11, 0xb3, 0x71, 0x0d, 0x35, 0xac, 0x56, 0xca, 0x1b, 0x70, 0x08, 0x3a,
+	0x39, 0xd1, 0x58, 0xd1, 0xd2, 0x50, 0x29, 0xea, 0xb0, 0x1b, 0xd0, 0xed, 0x7d, 0x9a, 0x9b, 0xf7,
+	0xe9, 0x81, 0xdd, 0x5a, 0x29, 0x5f, 0x79, 0x72, 0x63, 0xc3, 0x47, 0xa0, 0xa5, 0xd7, 0x3c, 0x93,
+	0xcc, 0x2d, 0x43, 0x3b, 0xa9, 0x2c, 0x18, 0x82, 0x7b, 0x39, 0xc1, 0x94, 0x23, 0x16, 0xb6, 0x86,
+	0xc1, 0x68, 0x3f, 0xa9, 0xcd, 0x93, 0xdd, 0x9f, 0x5f, 0x0d, 0x1a, 0xae, 0xd3, 0x5f, 0x82, 0xbd,
+	0xcd, 0x1a, 0xfe, 0x47, 0x8d, 0xcd, 0xec, 0x5b, 0x77, 0xb3, 0x47, 0x7f, 0x04, 0xe0, 0xfd, 0x39,
+	0x11, 0x39, 0x15, 0x85, 0x5f, 0x88, 0x99, 0x14, 0x17, 0x44, 0x69, 0x5b, 0x8b, 0xbd, 0x19, 0x11,
+	0x39, 0x51, 0x55, 0xb8, 0xca, 0x82, 0x5f, 0x80, 0x96, 0xef, 0xa7, 0x8b, 0xd6, 0x79, 0xf2, 0x41,
+	0xec, 0xdb, 0x17, 0xdb, 0x67, 0x2c, 0xae, 0x9e, 0xb1, 0x78, 0x26, 0xa9, 0xa8, 0x46, 0xbb, 0x72,
+	0xb7, 0x17, 0x41, 0xc6, 0xbd, 0x4a, 0xda, 0xe9, 0xb3, 0x9f, 0xdc, 0xd8, 0xf0, 0x63, 0x70, 0x28,
+	0xc8, 0xca, 0x54, 0xab, 0xba, 0x20, 0xb4, 0x58, 0x78, 0xad, 0x9a, 0xc9, 0x03, 0x4b, 0xb8, 0x45,
+	0x7d, 0xe6, 0x60, 0x3b, 0xcb, 0x0c, 0x69, 0x93, 0x12, 0xa5, 0xa4, 0xaa, 0x64, 0x6b, 0x5b, 0xe4,
+	0x2b, 0x0b, 0x44, 0x2f, 0x41, 0xe7, 0x74, 0xa9, 0xcd, 0x14, 0x31, 0x24, 0x30, 0xb1, 0x42, 0x56,
+	0x2f, 0x47, 0x55, 0x47, 0x6d, 0xbe, 0x73, 0x21, 0xd1, 0x4b, 0xd0, 0x7e, 0x2e, 0x8b, 0x09, 0x76,
+	0x2d, 0xdf, 0x94, 0x37, 0x78, 0xab, 0xb9, 0x5d, 0xb0, 0xe3, 0x76, 0xac, 0xd2, 0xdd, 0x1b, 0x76,
+	0x3c, 0xb9, 0x2e, 0x52, 0x5b, 0x39, 0x43, 0x86, 0xd4, 0x4f, 0x2c, 0xd7, 0xc5, 0x59, 0x05, 0x4d,
+	0xbf, 0x7d, 0x7d, 0xd5, 0x0f, 0xde, 0x5c, 0xf5, 0x83, 0x7f, 0xae, 0xfa, 0xc1, 0x2f, 0xd7, 0xfd,
+	0xc6, 0x9b, 0xeb, 0x7e, 0xe3, 0xcf, 0xeb, 0x7e, 0xe3, 0x87, 0xe3, 0x82, 0x9a, 0xc5, 0x32, 0x8b,
+	0xb1, 0xe4, 0x63, 0xac, 0xd6, 0xa5, 0x91, 0x8f, 0xa5, 0x2a, 0x1e, 0xe3, 0x05, 0xa2, 0xa2, 0xfa,
+	0xf6, 0x8c, 0x57, 0xf5, 0x1f, 0xb3, 0x2e, 0x89, 0xce, 0x5a, 0xee, 0x6b, 0xf2, 0xd9, 0x7f, 0x03,
+	0x00, 0x47, 0x79, 0x0d, 0xce, 0xa2, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -269,6 +666,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrictLogHandlers {
+		i--
+		if m.StrictLogHandlers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LogHandlerPolicies) > 0 {
+		for iNdEx := len(m.LogHandlerPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogHandlerPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DenomScalings) > 0 {
+		for iNdEx := len(m.DenomScalings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomScalings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxRefundRetryGas != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.MaxRefundRetryGas))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.CroBridgeContractAddresses) > 0 {
 		for iNdEx := len(m.CroBridgeContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CroBridgeContractAddresses[iNdEx])
@@ -315,7 +755,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
+func (m *LogHandlerPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -325,45 +765,140 @@ func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TokenMappingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogHandlerPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TokenMappingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogHandlerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Decimal != 0 {
-		i = encodeVarintCronos(dAtA, i, uint64(m.Decimal))
-		i--
-		dAtA[i] = 0x30
+	{
+		size := m.MaxAmountPerBlock.Size()
+		i -= size
+		if _, err := m.MaxAmountPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
+	i--
+	dAtA[i] = 0x22
+	if len(m.DeniedContracts) > 0 {
+		for iNdEx := len(m.DeniedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedContracts[iNdEx])
+			copy(dAtA[i:], m.DeniedContracts[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.DeniedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+	if len(m.EventName) > 0 {
+		i -= len(m.EventName)
+		copy(dAtA[i:], m.EventName)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.EventName)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomScaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomScaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomScaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMappingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMappingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimal != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Decimal))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
 		i = encodeVarintCronos(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
@@ -415,6 +950,147 @@ func (m *TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingRefundConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRefundConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRefundConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DustBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DustBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DustBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTemplate) > 0 {
+		i -= len(m.MsgTemplate)
+		copy(dAtA[i:], m.MsgTemplate)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.MsgTemplate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCronos(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCronos(v)
 	base := offset
@@ -455,6 +1131,68 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovCronos(uint64(l))
 		}
 	}
+	if m.MaxRefundRetryGas != 0 {
+		n += 1 + sovCronos(uint64(m.MaxRefundRetryGas))
+	}
+	if len(m.DenomScalings) > 0 {
+		for _, e := range m.DenomScalings {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	if len(m.LogHandlerPolicies) > 0 {
+		for _, e := range m.LogHandlerPolicies {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	if m.StrictLogHandlers {
+		n += 2
+	}
+	return n
+}
+
+func (m *LogHandlerPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventName)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	if len(m.DeniedContracts) > 0 {
+		for _, s := range m.DeniedContracts {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	l = m.MaxAmountPerBlock.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *DenomScaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
 	return n
 }
 
@@ -507,22 +1245,83 @@ func (m *TokenMapping) Size() (n int) {
 	return n
 }
 
-func sovCronos(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PendingRefundConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovCronos(uint64(m.Attempts))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovCronos(uint64(m.NextRetryHeight))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
 }
-func sozCronos(x uint64) (n int) {
-	return sovCronos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DustBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCronos
-			}
+
+func (m *LogAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.MsgTemplate)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
+}
+
+func sovCronos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCronos(x uint64) (n int) {
+	return sovCronos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -593,9 +1392,691 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronosAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronosAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoDeployment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAutoDeployment = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGas", wireType)
+			}
+			m.MaxCallbackGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CroBridgeContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CroBridgeContractAddresses = append(m.CroBridgeContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRefundRetryGas", wireType)
+			}
+			m.MaxRefundRetryGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRefundRetryGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomScalings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomScalings = append(m.DenomScalings, DenomScaling{})
+			if err := m.DenomScalings[len(m.DenomScalings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogHandlerPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogHandlerPolicies = append(m.LogHandlerPolicies, LogHandlerPolicy{})
+			if err := m.LogHandlerPolicies[len(m.LogHandlerPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictLogHandlers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictLogHandlers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogHandlerPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogHandlerPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogHandlerPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedContracts = append(m.DeniedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomScaling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomScaling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomScaling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMappingChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMappingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronosAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -623,13 +2104,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronosAdmin = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoDeployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
 			}
-			var v int
+			m.Decimal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -639,17 +2120,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Decimal |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableAutoDeployment = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGas", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxCallbackGas = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -659,14 +2189,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCallbackGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CroBridgeContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -694,7 +2237,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CroBridgeContractAddresses = append(m.CroBridgeContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -717,7 +2260,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
+func (m *PendingRefundConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -740,15 +2283,15 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMappingChangeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingRefundConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMappingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingRefundConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -776,13 +2319,13 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -792,29 +2335,30 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCronos
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCronos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			var stringLen uint64
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -824,27 +2368,33 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCronos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCronos
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -872,11 +2422,61 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DustBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DustBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DustBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -904,13 +2504,13 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Decimal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -920,11 +2520,25 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimal |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCronos(dAtA[iNdEx:])
@@ -946,7 +2560,7 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenMapping) Unmarshal(dAtA []byte) error {
+func (m *LogAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -969,15 +2583,15 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1005,11 +2619,11 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1037,7 +2651,39 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13,6 +13,11 @@ const (
 	codeErrContractAlreadyRegistered
 	codeErrDenomAlreadyMapped
 	codeErrSourceDenomContractMismatch
+	codeErrDenomScalingInvalid
+	codeErrLogActionInvalid
+	codeErrLogHandlerUnauthorized
+	codeErrMalformedLog
+	codeErrBlockListUncovered
 )
 
 // x/cronos module sentinel errors
@@ -27,5 +32,18 @@ var (
 		codeErrSourceDenomContractMismatch,
 		"source denom contract mismatch",
 	)
+	ErrDenomScalingInvalid    = errors.Register(ModuleName, codeErrDenomScalingInvalid, "denom scaling is invalid")
+	ErrLogActionInvalid       = errors.Register(ModuleName, codeErrLogActionInvalid, "log action is invalid")
+	ErrLogHandlerUnauthorized = errors.Register(
+		ModuleName,
+		codeErrLogHandlerUnauthorized,
+		"contract is not authorized to trigger the log handler",
+	)
+	ErrMalformedLog       = errors.Register(ModuleName, codeErrMalformedLog, "evm log is malformed")
+	ErrBlockListUncovered = errors.Register(
+		ModuleName,
+		codeErrBlockListUncovered,
+		"block list doesn't cover the bonded validators",
+	)
 	// this line is used by starport scaffolding # ibc/errors
 )
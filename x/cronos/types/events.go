@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,10 +15,22 @@ const (
 	AttributeKeyAmount                = "amount"
 	AttributeKeyReceiver              = "receiver"
 	AttributeKeyEthereumTokenContract = "ethereum_token_contract"
+	AttributeKeySuccess               = "success"
+	AttributeKeyError                 = "error"
+	AttributeKeyDenom                 = "denom"
+	AttributeKeyContract              = "contract"
+	AttributeKeyAutoContract          = "auto_contract"
+	AttributeKeyRefund                = "refund"
 
 	EventTypeConvertVouchers             = "convert_vouchers"
 	EventTypeTransferTokens              = "transfer_tokens"
 	EventTypeEthereumSendToCosmosHandled = "ethereum_send_to_cosmos_handled"
+	EventTypeRefundConversionFailed      = "refund_conversion_failed"
+	EventTypeRefundConversionRetried     = "refund_conversion_retried"
+	EventTypeDustClaimed                 = "dust_claimed"
+	EventTypeBlockListUpdated            = "block_list_updated"
+	EventTypeTokenMappingUpdated         = "token_mapping_updated"
+	EventTypeIbcConversion               = "ibc_conversion"
 )
 
 // NewConvertVouchersEvent constructs a new voucher convert sdk.Event
@@ -39,3 +52,66 @@ func NewTransferTokensEvent(sender, recipient string, amount fmt.Stringer) sdk.E
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewRefundConversionFailedEvent constructs a new sdk.Event for a refund conversion recorded for retry
+func NewRefundConversionFailedEvent(sender string, amount fmt.Stringer, reason string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRefundConversionFailed,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(AttributeKeyError, reason),
+	)
+}
+
+// NewRefundConversionRetriedEvent constructs a new sdk.Event for a retried refund conversion
+func NewRefundConversionRetriedEvent(sender string, amount fmt.Stringer, success bool) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRefundConversionRetried,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(AttributeKeySuccess, strconv.FormatBool(success)),
+	)
+}
+
+// NewDustClaimedEvent constructs a new sdk.Event for the dust balances refunded to an address
+func NewDustClaimedEvent(recipient string, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeDustClaimed,
+		sdk.NewAttribute(AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewBlockListUpdatedEvent constructs a new sdk.Event for a stored block list, the list itself is not revealed
+func NewBlockListUpdatedEvent(sender string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeBlockListUpdated,
+		sdk.NewAttribute(AttributeKeySender, sender),
+	)
+}
+
+// NewTokenMappingUpdatedEvent constructs a new sdk.Event for a changed denom to contract mapping,
+// an empty contract means the mapping is removed
+func NewTokenMappingUpdatedEvent(denom, contract string, autoContract bool) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTokenMappingUpdated,
+		sdk.NewAttribute(AttributeKeyDenom, denom),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyAutoContract, strconv.FormatBool(autoContract)),
+	)
+}
+
+// NewIbcConversionEvent constructs a new sdk.Event for the conversion of received or refunded ibc vouchers
+func NewIbcConversionEvent(receiver string, amount fmt.Stringer, refund bool, err error) sdk.Event {
+	event := sdk.NewEvent(
+		EventTypeIbcConversion,
+		sdk.NewAttribute(AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(AttributeKeyRefund, strconv.FormatBool(refund)),
+		sdk.NewAttribute(AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	)
+	if err != nil {
+		event = event.AppendAttributes(sdk.NewAttribute(AttributeKeyError, err.Error()))
+	}
+	return event
+}
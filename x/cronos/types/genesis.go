@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 // this line is used by starport scaffolding # ibc/genesistype/import
 
@@ -22,5 +28,63 @@ func (gs GenesisState) Validate() error {
 
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	seen := make(map[string]bool, len(gs.PendingRefundConversions))
+	for _, entry := range gs.PendingRefundConversions {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		key := entry.Sender + "/" + entry.Amount.Denom
+		if seen[key] {
+			return fmt.Errorf("duplicated pending refund conversion for %s", key)
+		}
+		seen[key] = true
+	}
+
+	seenDust := make(map[string]bool, len(gs.DustBalances))
+	for _, dust := range gs.DustBalances {
+		if err := dust.Validate(); err != nil {
+			return err
+		}
+		key := dust.Address + "/" + dust.Amount.Denom
+		if seenDust[key] {
+			return fmt.Errorf("duplicated dust balance for %s", key)
+		}
+		seenDust[key] = true
+	}
+
+	for _, action := range gs.LogActions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
+
+// Validate performs basic validation of a pending refund conversion
+func (e PendingRefundConversion) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Sender); err != nil {
+		return fmt.Errorf("invalid pending refund conversion sender %s: %w", e.Sender, err)
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid pending refund conversion amount %s: %w", e.Amount, err)
+	}
+	if !e.Amount.IsPositive() {
+		return fmt.Errorf("pending refund conversion amount must be positive: %s", e.Amount)
+	}
+	return nil
+}
+
+// Validate performs basic validation of a dust balance
+func (d DustBalance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.Address); err != nil {
+		return fmt.Errorf("invalid dust balance address %s: %w", d.Address, err)
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid dust balance amount %s: %w", d.Amount, err)
+	}
+	if !d.Amount.IsPositive() {
+		return fmt.Errorf("dust balance amount must be positive: %s", d.Amount)
+	}
+	return nil
+}
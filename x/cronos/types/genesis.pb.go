@@ -29,6 +29,12 @@ type GenesisState struct {
 	Params            Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	ExternalContracts []TokenMapping `protobuf:"bytes,2,rep,name=external_contracts,json=externalContracts,proto3" json:"external_contracts"`
 	AutoContracts     []TokenMapping `protobuf:"bytes,3,rep,name=auto_contracts,json=autoContracts,proto3" json:"auto_contracts"`
+	// the refund conversions still waiting to be retried
+	PendingRefundConversions []PendingRefundConversion `protobuf:"bytes,4,rep,name=pending_refund_conversions,json=pendingRefundConversions,proto3" json:"pending_refund_conversions"`
+	// the dust balances held by the module account
+	DustBalances []DustBalance `protobuf:"bytes,5,rep,name=dust_balances,json=dustBalances,proto3" json:"dust_balances"`
+	// the native actions bound to evm logs
+	LogActions []LogAction `protobuf:"bytes,6,rep,name=log_actions,json=logActions,proto3" json:"log_actions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +91,27 @@ func (m *GenesisState) GetAutoContracts() []TokenMapping {
 	return nil
 }
 
+func (m *GenesisState) GetPendingRefundConversions() []PendingRefundConversion {
+	if m != nil {
+		return m.PendingRefundConversions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDustBalances() []DustBalance {
+	if m != nil {
+		return m.DustBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLogActions() []LogAction {
+	if m != nil {
+		return m.LogActions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cronos.GenesisState")
 }
@@ -92,24 +119,30 @@ func init() {
 func init() { proto.RegisterFile("cronos/genesis.proto", fileDescriptor_997c9bf6ad78cc99) }
 
 var fileDescriptor_997c9bf6ad78cc99 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x2e, 0xca, 0xcf,
-	0xcb, 0x2f, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x62, 0x83, 0x88, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85, 0xf4, 0x41, 0x2c, 0x88,
-	0xac, 0x94, 0x30, 0x54, 0x0f, 0x84, 0x82, 0x08, 0x2a, 0x9d, 0x62, 0xe4, 0xe2, 0x71, 0x87, 0x18,
-	0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4, 0xc3, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xc4, 0xa7, 0x07, 0x55, 0x1f, 0x00, 0x16, 0x75, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x46, 0xc8, 0x93, 0x4b, 0x28, 0xb5, 0xa2, 0x24, 0xb5, 0x28,
-	0x2f, 0x31, 0x27, 0x3e, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0xa4, 0x58, 0x82, 0x49, 0x81,
-	0x59, 0x83, 0xdb, 0x48, 0x04, 0xa6, 0x33, 0x24, 0x3f, 0x3b, 0x35, 0xcf, 0x37, 0xb1, 0xa0, 0x20,
-	0x33, 0x2f, 0x1d, 0xaa, 0x5f, 0x10, 0xa6, 0xcb, 0x19, 0xa6, 0x49, 0xc8, 0x91, 0x8b, 0x2f, 0xb1,
-	0xb4, 0x24, 0x1f, 0xc9, 0x18, 0x66, 0x82, 0xc6, 0xf0, 0x82, 0x74, 0xc0, 0x8d, 0x70, 0xf2, 0x3e,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xa2, 0xca, 0x82, 0x92, 0x7c, 0xdd, 0xfc, 0xa2, 0x74,
-	0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x68, 0x80, 0xe8, 0x57, 0xc0, 0x18, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0xe0, 0x00, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xac, 0xa2, 0x1e,
-	0x6b, 0x01, 0x00, 0x00,
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x3f, 0x6f, 0xe2, 0x30,
+	0x1c, 0x86, 0x93, 0x83, 0xcb, 0x60, 0xfe, 0x48, 0x04, 0x86, 0x88, 0x21, 0xa0, 0x9b, 0x18, 0x0e,
+	0xa2, 0xe3, 0x96, 0x9b, 0x4e, 0x02, 0x4e, 0x3a, 0x9d, 0xae, 0x95, 0x2a, 0xda, 0xa9, 0x4b, 0x64,
+	0x1c, 0xd7, 0x44, 0x0d, 0xfe, 0x59, 0xb6, 0x53, 0xc1, 0xb7, 0xe8, 0xd7, 0xe8, 0x37, 0x61, 0x64,
+	0xec, 0x54, 0x55, 0xf0, 0x45, 0x2a, 0x1c, 0x07, 0x3a, 0x54, 0xea, 0x14, 0xeb, 0x7d, 0xdf, 0xe7,
+	0x51, 0x2c, 0xa3, 0x0e, 0x91, 0xc0, 0x41, 0x45, 0x8c, 0x72, 0xaa, 0x52, 0x35, 0x12, 0x12, 0x34,
+	0xf8, 0x5e, 0x91, 0x76, 0x3b, 0x0c, 0x18, 0x98, 0x28, 0x3a, 0x9e, 0x8a, 0xb6, 0xdb, 0xb6, 0x4c,
+	0xf1, 0x29, 0xc2, 0x6f, 0x4f, 0x15, 0x54, 0xff, 0x5b, 0x48, 0xae, 0x35, 0xd6, 0xd4, 0xff, 0x8e,
+	0x3c, 0x81, 0x25, 0x5e, 0xa9, 0xc0, 0xed, 0xbb, 0x83, 0xda, 0xb8, 0x39, 0xb2, 0xfb, 0x2b, 0x93,
+	0x4e, 0xab, 0xdb, 0x97, 0x9e, 0x33, 0xb7, 0x1b, 0xff, 0x1f, 0xf2, 0xe9, 0x5a, 0x53, 0xc9, 0x71,
+	0x16, 0x13, 0xe0, 0x5a, 0x62, 0xa2, 0x55, 0xf0, 0xa5, 0x5f, 0x19, 0xd4, 0xc6, 0x9d, 0x92, 0xbc,
+	0x81, 0x7b, 0xca, 0x2f, 0xb1, 0x10, 0x29, 0x67, 0x96, 0x6f, 0x95, 0xd4, 0xac, 0x84, 0xfc, 0x09,
+	0x6a, 0xe2, 0x5c, 0xc3, 0x3b, 0x4d, 0xe5, 0x53, 0x4d, 0xe3, 0x48, 0x9c, 0x15, 0x04, 0x75, 0x05,
+	0xe5, 0x49, 0xca, 0x59, 0x2c, 0xe9, 0x5d, 0xce, 0x93, 0xa3, 0xec, 0x81, 0x4a, 0x95, 0x02, 0x57,
+	0x41, 0xd5, 0xe8, 0x7a, 0xa7, 0xfb, 0x14, 0xcb, 0xb9, 0x19, 0xce, 0x4e, 0x3b, 0x6b, 0x0e, 0xc4,
+	0xc7, 0xb5, 0xf2, 0x7f, 0xa3, 0x46, 0x92, 0x2b, 0x1d, 0x2f, 0x70, 0x86, 0x39, 0xa1, 0x2a, 0xf8,
+	0x6a, 0xbc, 0xed, 0xd2, 0xfb, 0x27, 0x57, 0x7a, 0x5a, 0x74, 0xd6, 0x55, 0x4f, 0xce, 0x91, 0xf2,
+	0x7f, 0xa1, 0x5a, 0x06, 0x2c, 0xc6, 0x44, 0x9b, 0xbf, 0xf2, 0x0c, 0xdd, 0x2a, 0xe9, 0x0b, 0x60,
+	0x13, 0xd3, 0x58, 0x16, 0x65, 0x65, 0xa0, 0xa6, 0xff, 0xb7, 0xfb, 0xd0, 0xdd, 0xed, 0x43, 0xf7,
+	0x75, 0x1f, 0xba, 0x8f, 0x87, 0xd0, 0xd9, 0x1d, 0x42, 0xe7, 0xf9, 0x10, 0x3a, 0xb7, 0x3f, 0x58,
+	0xaa, 0x97, 0xf9, 0x62, 0x44, 0x60, 0x15, 0x11, 0xb9, 0x11, 0x1a, 0x86, 0x20, 0xd9, 0x90, 0x2c,
+	0x71, 0xca, 0xed, 0x7b, 0x47, 0xeb, 0xf2, 0xa0, 0x37, 0x82, 0xaa, 0x85, 0x67, 0xde, 0xff, 0xe7,
+	0xdb, 0x00, 0xc4, 0x70, 0x1d, 0x6f, 0x4a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +165,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LogActions) > 0 {
+		for iNdEx := len(m.LogActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DustBalances) > 0 {
+		for iNdEx := len(m.DustBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingRefundConversions) > 0 {
+		for iNdEx := len(m.PendingRefundConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRefundConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AutoContracts) > 0 {
 		for iNdEx := len(m.AutoContracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -204,6 +279,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingRefundConversions) > 0 {
+		for _, e := range m.PendingRefundConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DustBalances) > 0 {
+		for _, e := range m.DustBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogActions) > 0 {
+		for _, e := range m.LogActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -343,6 +436,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRefundConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRefundConversions = append(m.PendingRefundConversions, PendingRefundConversion{})
+			if err := m.PendingRefundConversions[len(m.PendingRefundConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustBalances = append(m.DustBalances, DustBalance{})
+			if err := m.DustBalances[len(m.DustBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogActions = append(m.LogActions, LogAction{})
+			if err := m.LogActions[len(m.LogActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
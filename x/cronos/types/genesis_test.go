@@ -4,9 +4,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesisStateValidate(t *testing.T) {
+	pendingRefundSender := sdk.AccAddress([]byte("pending_refund_sender")).String()
 	testCases := []struct {
 		name         string
 		genesisState GenesisState
@@ -28,6 +33,38 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"duplicated pending refund conversion",
+			GenesisState{
+				Params: DefaultParams(),
+				PendingRefundConversions: []PendingRefundConversion{
+					{Sender: pendingRefundSender, Amount: sdk.NewCoin(IbcCroDenomDefaultValue, sdkmath.NewInt(1))},
+					{Sender: pendingRefundSender, Amount: sdk.NewCoin(IbcCroDenomDefaultValue, sdkmath.NewInt(2))},
+				},
+			},
+			true,
+		},
+		{
+			"zero pending refund conversion",
+			GenesisState{
+				Params: DefaultParams(),
+				PendingRefundConversions: []PendingRefundConversion{
+					{Sender: pendingRefundSender, Amount: sdk.NewCoin(IbcCroDenomDefaultValue, sdkmath.ZeroInt())},
+				},
+			},
+			true,
+		},
+		{
+			"duplicated dust balance",
+			GenesisState{
+				Params: DefaultParams(),
+				DustBalances: []DustBalance{
+					{Address: pendingRefundSender, Amount: sdk.NewCoin("basecro", sdkmath.NewInt(1))},
+					{Address: pendingRefundSender, Amount: sdk.NewCoin("basecro", sdkmath.NewInt(2))},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
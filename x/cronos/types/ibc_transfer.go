@@ -0,0 +1,10 @@
+package types
+
+// The status of the ibc transfers returned by the IbcTransferStatus query, refund-conversion-failed is only reported
+// while the refund conversion is pending a retry.
+const (
+	IbcTransferStatusPending                = "pending"
+	IbcTransferStatusAcked                  = "acked"
+	IbcTransferStatusRefunded               = "refunded"
+	IbcTransferStatusRefundConversionFailed = "refund-conversion-failed"
+)
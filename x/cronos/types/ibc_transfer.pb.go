@@ -0,0 +1,1385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cronos/ibc_transfer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IbcTransfer is the ibc transfer packet sent by a tx and its current status
+type IbcTransfer struct {
+	SourcePort         string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel      string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	Sequence           uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	DestinationPort    string `protobuf:"bytes,4,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	DestinationChannel string `protobuf:"bytes,5,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	Denom              string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount             string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sender             string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver           string `protobuf:"bytes,9,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// status is one of pending, acked, refunded and refund-conversion-failed
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// send_tx_hash is the cosmos hash of the tx which sent the packet
+	SendTxHash string `protobuf:"bytes,11,opt,name=send_tx_hash,json=sendTxHash,proto3" json:"send_tx_hash,omitempty"`
+	// result_tx_hash is the cosmos hash of the tx which acknowledged or timed
+	// out the packet, empty if pending
+	ResultTxHash string `protobuf:"bytes,12,opt,name=result_tx_hash,json=resultTxHash,proto3" json:"result_tx_hash,omitempty"`
+	// error is the error acknowledgement or the refund conversion failure
+	Error string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *IbcTransfer) Reset()         { *m = IbcTransfer{} }
+func (m *IbcTransfer) String() string { return proto.CompactTextString(m) }
+func (*IbcTransfer) ProtoMessage()    {}
+func (*IbcTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c87314cd4cb56a, []int{0}
+}
+func (m *IbcTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcTransfer.Merge(m, src)
+}
+func (m *IbcTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcTransfer proto.InternalMessageInfo
+
+func (m *IbcTransfer) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IbcTransfer) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetSendTxHash() string {
+	if m != nil {
+		return m.SendTxHash
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetResultTxHash() string {
+	if m != nil {
+		return m.ResultTxHash
+	}
+	return ""
+}
+
+func (m *IbcTransfer) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QueryIbcTransferStatusRequest is the request type for the
+// IbcTransferQuery/IbcTransferStatus RPC method.
+type QueryIbcTransferStatusRequest struct {
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryIbcTransferStatusRequest) Reset()         { *m = QueryIbcTransferStatusRequest{} }
+func (m *QueryIbcTransferStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcTransferStatusRequest) ProtoMessage()    {}
+func (*QueryIbcTransferStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c87314cd4cb56a, []int{1}
+}
+func (m *QueryIbcTransferStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcTransferStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcTransferStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIbcTransferStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcTransferStatusRequest.Merge(m, src)
+}
+func (m *QueryIbcTransferStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcTransferStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcTransferStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIbcTransferStatusRequest proto.InternalMessageInfo
+
+func (m *QueryIbcTransferStatusRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryIbcTransferStatusResponse is the response type for the
+// IbcTransferQuery/IbcTransferStatus RPC method.
+type QueryIbcTransferStatusResponse struct {
+	Transfers []IbcTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+}
+
+func (m *QueryIbcTransferStatusResponse) Reset()         { *m = QueryIbcTransferStatusResponse{} }
+func (m *QueryIbcTransferStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcTransferStatusResponse) ProtoMessage()    {}
+func (*QueryIbcTransferStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c87314cd4cb56a, []int{2}
+}
+func (m *QueryIbcTransferStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcTransferStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcTransferStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIbcTransferStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcTransferStatusResponse.Merge(m, src)
+}
+func (m *QueryIbcTransferStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcTransferStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcTransferStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIbcTransferStatusResponse proto.InternalMessageInfo
+
+func (m *QueryIbcTransferStatusResponse) GetTransfers() []IbcTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IbcTransfer)(nil), "cronos.IbcTransfer")
+	proto.RegisterType((*QueryIbcTransferStatusRequest)(nil), "cronos.QueryIbcTransferStatusRequest")
+	proto.RegisterType((*QueryIbcTransferStatusResponse)(nil), "cronos.QueryIbcTransferStatusResponse")
+}
+
+func init() { proto.RegisterFile("cronos/ibc_transfer.proto", fileDescriptor_a9c87314cd4cb56a) }
+
+var fileDescriptor_a9c87314cd4cb56a = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x8f, 0x36, 0x93, 0xb4, 0x94, 0x6d, 0x04, 0x4b, 0x04, 0x6e, 0x14, 0x51, 0x14,
+	0x90, 0x1a, 0xd3, 0x72, 0x80, 0x73, 0xb9, 0x80, 0xb8, 0x40, 0xe8, 0x05, 0x2e, 0x91, 0xe3, 0x0c,
+	0xb6, 0xa5, 0x64, 0xc7, 0xec, 0xae, 0xab, 0x44, 0x88, 0x0b, 0xbf, 0x00, 0x09, 0xfe, 0x02, 0x07,
+	0xfe, 0x49, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x87, 0xa0, 0xec, 0xda, 0xc1, 0x08, 0x01,
+	0xb7, 0x7d, 0x6f, 0xde, 0xbc, 0xb7, 0x5f, 0x03, 0xd7, 0x02, 0x49, 0x82, 0x94, 0x17, 0x8f, 0x82,
+	0xa1, 0x96, 0xbe, 0x50, 0xaf, 0x50, 0xf6, 0x13, 0x49, 0x9a, 0x58, 0xcd, 0x96, 0xda, 0xad, 0x90,
+	0x42, 0x32, 0x94, 0xb7, 0x5a, 0xd9, 0x6a, 0xfb, 0x7a, 0x48, 0x14, 0x4e, 0xd0, 0xf3, 0x93, 0xd8,
+	0xf3, 0x85, 0x20, 0xed, 0xeb, 0x98, 0x84, 0xb2, 0xd5, 0xee, 0xa7, 0x32, 0x34, 0x1e, 0x8f, 0x82,
+	0xd3, 0xcc, 0x91, 0xed, 0x43, 0x43, 0x51, 0x2a, 0x03, 0x1c, 0x26, 0x24, 0x35, 0x77, 0x3a, 0x4e,
+	0xaf, 0x3e, 0x00, 0x4b, 0x3d, 0x25, 0xa9, 0xd9, 0x01, 0xec, 0x64, 0x82, 0x20, 0xf2, 0x85, 0xc0,
+	0x09, 0xdf, 0x30, 0x9a, 0x6d, 0xcb, 0x3e, 0xb4, 0x24, 0x6b, 0xc3, 0x96, 0xc2, 0xd7, 0x29, 0x8a,
+	0x00, 0x79, 0xb9, 0xe3, 0xf4, 0x2a, 0x83, 0x35, 0x66, 0xb7, 0x61, 0x77, 0x8c, 0x4a, 0xc7, 0xc2,
+	0xec, 0xc4, 0x06, 0x55, 0x8c, 0xc9, 0xa5, 0x02, 0x6f, 0xd2, 0x3c, 0xd8, 0x2b, 0x4a, 0xf3, 0xc8,
+	0xaa, 0x51, 0xb3, 0x42, 0x29, 0xcf, 0x6d, 0x41, 0x75, 0x8c, 0x82, 0xa6, 0xbc, 0x66, 0x24, 0x16,
+	0xb0, 0x2b, 0x50, 0xf3, 0xa7, 0x94, 0x0a, 0xcd, 0x37, 0x0d, 0x9d, 0xa1, 0x15, 0xaf, 0x50, 0x8c,
+	0x51, 0xf2, 0x2d, 0xcb, 0x5b, 0xb4, 0xda, 0xbd, 0xc4, 0x00, 0xe3, 0x33, 0x94, 0xbc, 0x6e, 0x2a,
+	0x6b, 0x6c, 0x7a, 0xb4, 0xaf, 0x53, 0xc5, 0x21, 0xeb, 0x31, 0x88, 0x75, 0xa0, 0xb9, 0xea, 0x1e,
+	0xea, 0xd9, 0x30, 0xf2, 0x55, 0xc4, 0x1b, 0xd9, 0xd5, 0xa1, 0x18, 0x9f, 0xce, 0x1e, 0xf9, 0x2a,
+	0x62, 0x37, 0x61, 0x47, 0xa2, 0x4a, 0x27, 0x7a, 0xad, 0x69, 0x1a, 0x4d, 0xd3, 0xb2, 0x99, 0xaa,
+	0x05, 0x55, 0x94, 0x92, 0x24, 0xdf, 0xb6, 0x27, 0x30, 0xa0, 0xfb, 0x00, 0x6e, 0x3c, 0x4b, 0x51,
+	0xce, 0x0b, 0x6f, 0xf5, 0xdc, 0xe4, 0x0e, 0x56, 0xb7, 0xaa, 0x34, 0xbb, 0x0a, 0x9b, 0xb9, 0xab,
+	0x7d, 0xb4, 0x9a, 0x36, 0x7e, 0xdd, 0x17, 0xe0, 0xfe, 0xad, 0x53, 0x25, 0x24, 0x14, 0xb2, 0xfb,
+	0x50, 0xcf, 0x7f, 0x94, 0xe2, 0x4e, 0xa7, 0xdc, 0x6b, 0x1c, 0xef, 0xf5, 0xed, 0x9f, 0xea, 0x17,
+	0xba, 0x4e, 0x2a, 0xe7, 0xdf, 0xf6, 0x4b, 0x83, 0x5f, 0xda, 0xe3, 0xcf, 0x0e, 0xec, 0x16, 0x04,
+	0x26, 0x86, 0x7d, 0x74, 0xe0, 0xf2, 0x1f, 0x59, 0xec, 0x20, 0x37, 0xfc, 0xe7, 0x29, 0xda, 0xb7,
+	0xfe, 0x27, 0xb3, 0x5b, 0xee, 0xde, 0x7d, 0xf7, 0xe5, 0xc7, 0x87, 0x8d, 0x3b, 0xac, 0xe7, 0x65,
+	0x63, 0x71, 0x76, 0xf4, 0xdb, 0x64, 0x0c, 0xed, 0xa3, 0x78, 0x6f, 0xb2, 0x3b, 0x79, 0x7b, 0xf2,
+	0xe4, 0x7c, 0xe1, 0x3a, 0x17, 0x0b, 0xd7, 0xf9, 0xbe, 0x70, 0x9d, 0xf7, 0x4b, 0xb7, 0x74, 0xb1,
+	0x74, 0x4b, 0x5f, 0x97, 0x6e, 0xe9, 0xe5, 0x51, 0x18, 0xeb, 0x28, 0x1d, 0xf5, 0x03, 0x9a, 0x7a,
+	0x81, 0x9c, 0x27, 0x9a, 0x0e, 0x49, 0x86, 0x87, 0x41, 0xe4, 0xc7, 0x22, 0xb7, 0x9f, 0xe5, 0x0b,
+	0x3d, 0x4f, 0x50, 0x8d, 0x6a, 0x66, 0x78, 0xee, 0xfd, 0x1c, 0x00, 0xaf, 0x77, 0x20, 0x38, 0x95,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IbcTransferQueryClient is the client API for IbcTransferQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IbcTransferQueryClient interface {
+	// IbcTransferStatus queries the status of the ibc transfers sent by a tx,
+	// the tx hash can be either an eth tx hash or a cosmos tx hash.
+	IbcTransferStatus(ctx context.Context, in *QueryIbcTransferStatusRequest, opts ...grpc.CallOption) (*QueryIbcTransferStatusResponse, error)
+}
+
+type ibcTransferQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewIbcTransferQueryClient(cc grpc1.ClientConn) IbcTransferQueryClient {
+	return &ibcTransferQueryClient{cc}
+}
+
+func (c *ibcTransferQueryClient) IbcTransferStatus(ctx context.Context, in *QueryIbcTransferStatusRequest, opts ...grpc.CallOption) (*QueryIbcTransferStatusResponse, error) {
+	out := new(QueryIbcTransferStatusResponse)
+	err := c.cc.Invoke(ctx, "/cronos.IbcTransferQuery/IbcTransferStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IbcTransferQueryServer is the server API for IbcTransferQuery service.
+type IbcTransferQueryServer interface {
+	// IbcTransferStatus queries the status of the ibc transfers sent by a tx,
+	// the tx hash can be either an eth tx hash or a cosmos tx hash.
+	IbcTransferStatus(context.Context, *QueryIbcTransferStatusRequest) (*QueryIbcTransferStatusResponse, error)
+}
+
+// UnimplementedIbcTransferQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedIbcTransferQueryServer struct {
+}
+
+func (*UnimplementedIbcTransferQueryServer) IbcTransferStatus(ctx context.Context, req *QueryIbcTransferStatusRequest) (*QueryIbcTransferStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcTransferStatus not implemented")
+}
+
+func RegisterIbcTransferQueryServer(s grpc1.Server, srv IbcTransferQueryServer) {
+	s.RegisterService(&_IbcTransferQuery_serviceDesc, srv)
+}
+
+func _IbcTransferQuery_IbcTransferStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIbcTransferStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IbcTransferQueryServer).IbcTransferStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.IbcTransferQuery/IbcTransferStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IbcTransferQueryServer).IbcTransferStatus(ctx, req.(*QueryIbcTransferStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IbcTransferQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cronos.IbcTransferQuery",
+	HandlerType: (*IbcTransferQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IbcTransferStatus",
+			Handler:    _IbcTransferQuery_IbcTransferStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cronos/ibc_transfer.proto",
+}
+
+func (m *IbcTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ResultTxHash) > 0 {
+		i -= len(m.ResultTxHash)
+		copy(dAtA[i:], m.ResultTxHash)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.ResultTxHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SendTxHash) > 0 {
+		i -= len(m.SendTxHash)
+		copy(dAtA[i:], m.SendTxHash)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.SendTxHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcTransferStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcTransferStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcTransferStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintIbcTransfer(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcTransferStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcTransferStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcTransferStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIbcTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIbcTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIbcTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IbcTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovIbcTransfer(uint64(m.Sequence))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.SendTxHash)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.ResultTxHash)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIbcTransferStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovIbcTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIbcTransferStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovIbcTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIbcTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbcTransfer(x uint64) (n int) {
+	return sovIbcTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IbcTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIbcTransferStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIbcTransferStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIbcTransferStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIbcTransferStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbcTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIbcTransferStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIbcTransferStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, IbcTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbcTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbcTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIbcTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIbcTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbcTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIbcTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIbcTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIbcTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIbcTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIbcTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIbcTransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,10 +9,13 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v11/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v11/modules/core/04-channel/types"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +58,13 @@ type EvmLogHandler interface {
 		addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte)) error
 }
 
+// EvmLogDispatcher defines the interface for dispatching the evm logs not handled by an EvmLogHandler
+type EvmLogDispatcher interface {
+	// Dispatch processes the log if it's bound to an action, the logs not bound are ignored
+	Dispatch(ctx sdk.Context, contract common.Address, topics []common.Hash, data []byte,
+		addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte)) error
+}
+
 // EvmKeeper defines the interface for evm keeper
 type EvmKeeper interface {
 	GetNonce(ctx sdk.Context, addr common.Address) uint64
@@ -66,7 +76,34 @@ type EvmKeeper interface {
 	EthereumTx(goCtx context.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error)
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	RefundGas(ctx sdk.Context, msg *core.Message, leftoverGas uint64, denom string) error
 	ChainID() *big.Int
+
+	// to simulate the bundles
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int, denom string) error
+	SetCode(ctx sdk.Context, codeHash []byte, code []byte)
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
+	PostTxProcessing(ctx sdk.Context, msg *core.Message, receipt *ethtypes.Receipt) error
+}
+
+// E2EEKeeper defines the expected e2ee keeper to verify the block list covers the bonded validators.
+type E2EEKeeper interface {
+	ValidatorsCoverage(
+		ctx context.Context,
+		req *e2eetypes.ValidatorsCoverageRequest,
+	) (*e2eetypes.ValidatorsCoverageResponse, error)
+}
+
+// E2EEKeyKeeper defines the expected e2ee keeper of the e2ee precompiled contract.
+type E2EEKeyKeeper interface {
+	RegisterEncryptionKey(
+		ctx context.Context,
+		req *e2eetypes.MsgRegisterEncryptionKey,
+	) (*e2eetypes.MsgRegisterEncryptionKeyResponse, error)
+	Keys(ctx context.Context, req *e2eetypes.KeysRequest) (*e2eetypes.KeysResponse, error)
 }
 
 // CronosKeeper defines the interface for cronos keeper
@@ -94,3 +131,10 @@ type IbcKeeper interface {
 	Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*channeltypes.MsgTimeoutResponse, error)
 	TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeoutOnClose) (*channeltypes.MsgTimeoutOnCloseResponse, error)
 }
+
+// BlockQuerier serves the queries computed from the committed blocks and their results, they are not part of
+// the state, so the queries are served by the node rather than the keeper.
+type BlockQuerier interface {
+	TransactionReceiptsByBlock(goCtx context.Context, req *QueryTransactionReceiptsByBlockRequest) (*QueryTransactionReceiptsByBlockResponse, error)
+	ReplayBlockByHeight(goCtx context.Context, req *QueryReplayBlockByHeightRequest) (*QueryReplayBlockByHeightResponse, error)
+}
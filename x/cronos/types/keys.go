@@ -1,7 +1,13 @@
 package types
 
 import (
+	"encoding/binary"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -14,8 +20,8 @@ const (
 	// RouterKey is the message route for slashing
 	RouterKey = ModuleName
 
-	// MemStoreKey defines the in-memory store key
-	MemStoreKey = "mem_cronos"
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_cronos"
 
 	// this line is used by starport scaffolding # ibc/keys/name
 )
@@ -28,6 +34,15 @@ const (
 	paramsKey
 	prefixAdminToPermissions
 	prefixBlockList
+	prefixPendingRefundConversion
+	prefixDust
+	prefixLogAction
+	prefixPendingRefundConversionByHeight
+)
+
+// prefix bytes for the cronos transient store
+const (
+	prefixLogHandlerUsage = iota + 1
 )
 
 // KVStore key prefixes
@@ -39,6 +54,17 @@ var (
 	ParamsKey                   = []byte{paramsKey}
 	KeyPrefixAdminToPermissions = []byte{prefixAdminToPermissions}
 	KeyPrefixBlockList          = []byte{prefixBlockList}
+
+	KeyPrefixPendingRefundConversion = []byte{prefixPendingRefundConversion}
+	KeyPrefixDust                    = []byte{prefixDust}
+	KeyPrefixLogAction               = []byte{prefixLogAction}
+
+	KeyPrefixPendingRefundConversionByHeight = []byte{prefixPendingRefundConversionByHeight}
+)
+
+// TransientStore key prefixes
+var (
+	KeyPrefixLogHandlerUsage = []byte{prefixLogHandlerUsage}
 )
 
 // this line is used by starport scaffolding # ibc/keys/port
@@ -62,3 +88,44 @@ func ContractToDenomKey(contract []byte) []byte {
 func AdminToPermissionsKey(address sdk.AccAddress) []byte {
 	return append(KeyPrefixAdminToPermissions, address.Bytes()...)
 }
+
+// PendingRefundConversionsKey defines the store key prefix for the pending refund conversions of an address
+func PendingRefundConversionsKey(sender sdk.AccAddress) []byte {
+	return append(KeyPrefixPendingRefundConversion, address.MustLengthPrefix(sender)...)
+}
+
+// PendingRefundConversionKey defines the store key for the pending refund conversion of an address and denom
+func PendingRefundConversionKey(sender sdk.AccAddress, denom string) []byte {
+	return append(PendingRefundConversionsKey(sender), denom...)
+}
+
+// PendingRefundConversionsByHeightKey defines the store key prefix for the pending refund conversions due at the height
+func PendingRefundConversionsByHeightKey(height int64) []byte {
+	return binary.BigEndian.AppendUint64(slices.Clone(KeyPrefixPendingRefundConversionByHeight), uint64(height))
+}
+
+// PendingRefundConversionByHeightKey defines the store key indexing the pending refund conversion of an address and
+// denom by its next retry height
+func PendingRefundConversionByHeightKey(height int64, sender sdk.AccAddress, denom string) []byte {
+	return append(append(PendingRefundConversionsByHeightKey(height), address.MustLengthPrefix(sender)...), denom...)
+}
+
+// DustBalancesKey defines the store key prefix for the dust balances of an address
+func DustBalancesKey(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixDust, address.MustLengthPrefix(addr)...)
+}
+
+// DustBalanceKey defines the store key for the dust balance of an address and denom
+func DustBalanceKey(addr sdk.AccAddress, denom string) []byte {
+	return append(DustBalancesKey(addr), denom...)
+}
+
+// LogActionKey defines the store key for the log action bound to the event of a contract
+func LogActionKey(contract common.Address, eventID common.Hash) []byte {
+	return append(append(KeyPrefixLogAction, contract.Bytes()...), eventID.Bytes()...)
+}
+
+// LogHandlerUsageKey defines the transient store key for the amount handled by a log handler for a contract in the block
+func LogHandlerUsageKey(eventName string, contract common.Address) []byte {
+	return append(append(KeyPrefixLogHandlerUsage, contract.Bytes()...), eventName...)
+}
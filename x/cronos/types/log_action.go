@@ -0,0 +1,131 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// LogActionContractPlaceholder is replaced with the bech32 account of the contract emitting the log
+	LogActionContractPlaceholder = "contract"
+	// LogActionDenomPlaceholder is replaced with the native denom mapped to the contract emitting the log
+	LogActionDenomPlaceholder = "denom"
+
+	logActionPlaceholderPrefix = "$"
+	logActionTypeField         = "@type"
+)
+
+// LogActionMsgTypes are the native messages which can be bound to evm logs
+var LogActionMsgTypes = map[string]bool{
+	"/cosmos.bank.v1beta1.MsgSend":              true,
+	"/ibc.applications.transfer.v1.MsgTransfer": true,
+	"/cosmos.staking.v1beta1.MsgDelegate":       true,
+}
+
+// Validate performs basic validation of a log action
+func (a LogAction) Validate() error {
+	if !common.IsHexAddress(a.Contract) || (common.HexToAddress(a.Contract) == common.Address{}) {
+		return fmt.Errorf("invalid log action contract %s: must be a non-zero EVM hex address", a.Contract)
+	}
+	event, err := a.ParseEvent()
+	if err != nil {
+		return err
+	}
+	template, err := a.ParseMsgTemplate()
+	if err != nil {
+		return err
+	}
+
+	names := map[string]bool{
+		LogActionContractPlaceholder: true,
+		LogActionDenomPlaceholder:    true,
+	}
+	for _, input := range event.Inputs {
+		names[input.Name] = true
+	}
+	_, err = RenderLogActionTemplate(template, func(name string) (string, error) {
+		if !names[name] {
+			return "", fmt.Errorf("unknown log action placeholder: %s", name)
+		}
+		return "", nil
+	})
+	return err
+}
+
+// ParseEvent decodes the abi of the event bound by the log action
+func (a LogAction) ParseEvent() (abi.Event, error) {
+	parsed, err := abi.JSON(strings.NewReader("[" + a.Event + "]"))
+	if err != nil {
+		return abi.Event{}, fmt.Errorf("invalid log action event abi: %w", err)
+	}
+	if len(parsed.Events) != 1 {
+		return abi.Event{}, fmt.Errorf("invalid log action event abi: expect one event, got %d", len(parsed.Events))
+	}
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			return abi.Event{}, fmt.Errorf("invalid log action event abi: %s is anonymous", event.Name)
+		}
+		for _, input := range event.Inputs {
+			if input.Name == "" {
+				return abi.Event{}, fmt.Errorf("invalid log action event abi: %s has unnamed arguments", event.Name)
+			}
+		}
+		return event, nil
+	}
+	return abi.Event{}, nil
+}
+
+// ParseMsgTemplate decodes the json message template of the log action, the message type must be
+// one of LogActionMsgTypes
+func (a LogAction) ParseMsgTemplate() (map[string]interface{}, error) {
+	var template map[string]interface{}
+	// keep the numbers as is when the template is encoded again
+	decoder := json.NewDecoder(strings.NewReader(a.MsgTemplate))
+	decoder.UseNumber()
+	if err := decoder.Decode(&template); err != nil {
+		return nil, fmt.Errorf("invalid log action message template: %w", err)
+	}
+	typeURL, _ := template[logActionTypeField].(string)
+	if !LogActionMsgTypes[typeURL] {
+		return nil, fmt.Errorf("message type %q is not allowed for log actions", typeURL)
+	}
+	return template, nil
+}
+
+// RenderLogActionTemplate replaces the string values "$<name>" in the decoded template with the values
+// returned by resolve.
+func RenderLogActionTemplate(template interface{}, resolve func(name string) (string, error)) (interface{}, error) {
+	switch v := template.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			rendered, err := RenderLogActionTemplate(value, resolve)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = rendered
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			rendered, err := RenderLogActionTemplate(value, resolve)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	case string:
+		if !strings.HasPrefix(v, logActionPlaceholderPrefix) {
+			return v, nil
+		}
+		return resolve(strings.TrimPrefix(v, logActionPlaceholderPrefix))
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogActionValidate(t *testing.T) {
+	const (
+		contract = "0x0000000000000000000000000000000000000101"
+		event    = `{"type":"event","name":"Payout","inputs":[{"name":"recipient","type":"address","indexed":true},{"name":"amount","type":"uint256"}]}`
+		template = `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"$contract","to_address":"$recipient","amount":[{"denom":"$denom","amount":"$amount"}]}`
+	)
+	testCases := []struct {
+		name    string
+		action  LogAction
+		wantErr bool
+	}{
+		{"valid", LogAction{Contract: contract, Event: event, MsgTemplate: template}, false},
+		{"zero contract", LogAction{Contract: "0x0000000000000000000000000000000000000000", Event: event, MsgTemplate: template}, true},
+		{"invalid event abi", LogAction{Contract: contract, Event: `{"type":"event"`, MsgTemplate: template}, true},
+		{
+			"not allowed message type",
+			LogAction{Contract: contract, Event: event, MsgTemplate: `{"@type":"/cosmos.gov.v1.MsgVote","voter":"$contract"}`},
+			true,
+		},
+		{
+			"unknown placeholder",
+			LogAction{Contract: contract, Event: event, MsgTemplate: `{"@type":"/cosmos.bank.v1beta1.MsgSend","to_address":"$receiver"}`},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.wantErr, tc.action.Validate() != nil)
+		})
+	}
+}
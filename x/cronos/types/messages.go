@@ -23,6 +23,9 @@ var (
 	_ sdk.Msg = &MsgTurnBridge{}
 	_ sdk.Msg = &MsgUpdatePermissions{}
 	_ sdk.Msg = &MsgStoreBlockList{}
+	_ sdk.Msg = &MsgClaimDust{}
+	_ sdk.Msg = &MsgSetLogAction{}
+	_ sdk.Msg = &MsgDeleteLogAction{}
 )
 
 func NewMsgConvertVouchers(address string, coins sdk.Coins) *MsgConvertVouchers {
@@ -202,3 +205,57 @@ func (msg *MsgStoreBlockList) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgClaimDust(address string) *MsgClaimDust {
+	return &MsgClaimDust{
+		Address: address,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgClaimDust) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+func NewMsgSetLogAction(authority string, action LogAction) *MsgSetLogAction {
+	return &MsgSetLogAction{
+		Authority: authority,
+		Action:    action,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgSetLogAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if err := msg.Action.Validate(); err != nil {
+		return errors.Wrap(ErrLogActionInvalid, err.Error())
+	}
+	return nil
+}
+
+func NewMsgDeleteLogAction(authority, contract, eventID string) *MsgDeleteLogAction {
+	return &MsgDeleteLogAction{
+		Authority: authority,
+		Contract:  contract,
+		EventId:   eventID,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgDeleteLogAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if !common.IsHexAddress(msg.Contract) {
+		return errors.Wrapf(ErrLogActionInvalid, "invalid contract address %s", msg.Contract)
+	}
+	if len(common.FromHex(msg.EventId)) != common.HashLength {
+		return errors.Wrapf(ErrLogActionInvalid, "invalid event id %s", msg.EventId)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -24,6 +26,14 @@ var (
 	KeyMaxCallbackGas = []byte("MaxCallbackGas")
 	// KeyCroBridgeContractAddresses is store's key for the authorized CroBridge contract addresses
 	KeyCroBridgeContractAddresses = []byte("CroBridgeContractAddresses")
+	// KeyMaxRefundRetryGas is store's key for the MaxRefundRetryGas
+	KeyMaxRefundRetryGas = []byte("MaxRefundRetryGas")
+	// KeyDenomScalings is store's key for the DenomScalings
+	KeyDenomScalings = []byte("DenomScalings")
+	// KeyLogHandlerPolicies is store's key for the LogHandlerPolicies
+	KeyLogHandlerPolicies = []byte("LogHandlerPolicies")
+	// KeyStrictLogHandlers is store's key for the StrictLogHandlers
+	KeyStrictLogHandlers = []byte("StrictLogHandlers")
 )
 
 const (
@@ -31,15 +41,45 @@ const (
 	IbcTimeoutDefaultValue     = uint64(86400000000000) // 1 day
 	MaxCallbackGasDefaultValue = uint64(50000)
 	MaxIbcTimeoutValue         = uint64(30 * 24 * time.Hour) // 30 days
+	// MaxRefundRetryGasDefaultValue is enough for a handful of CRC21 conversions per block
+	MaxRefundRetryGasDefaultValue = uint64(1000000)
 )
 
+// The event names of the evm log handlers which can be restricted by a LogHandlerPolicy.
+const (
+	LogHandlerSendToAccount = "__CronosSendToAccount"
+	LogHandlerSendToIbc     = "__CronosSendToIbc"
+	LogHandlerSendCroToIbc  = "__CronosSendCroToIbc"
+	// LogHandlerLogAction restricts the native messages of the log actions, the amount is the number of messages.
+	LogHandlerLogAction = "__CronosLogAction"
+)
+
+// LogHandlerEventNames are the event names accepted by the LogHandlerPolicy.
+var LogHandlerEventNames = []string{
+	LogHandlerSendToAccount,
+	LogHandlerSendToIbc,
+	LogHandlerSendCroToIbc,
+	LogHandlerLogAction,
+}
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new parameter configuration for the cronos module
-func NewParams(ibcCroDenom string, ibcTimeout uint64, cronosAdmin string, enableAutoDeployment bool, maxCallbackGas uint64, croBridgeContractAddresses []string) Params {
+func NewParams(
+	ibcCroDenom string,
+	ibcTimeout uint64,
+	cronosAdmin string,
+	enableAutoDeployment bool,
+	maxCallbackGas uint64,
+	croBridgeContractAddresses []string,
+	maxRefundRetryGas uint64,
+	denomScalings []DenomScaling,
+	logHandlerPolicies []LogHandlerPolicy,
+	strictLogHandlers bool,
+) Params {
 	return Params{
 		IbcCroDenom:                ibcCroDenom,
 		IbcTimeout:                 ibcTimeout,
@@ -47,6 +87,10 @@ func NewParams(ibcCroDenom string, ibcTimeout uint64, cronosAdmin string, enable
 		EnableAutoDeployment:       enableAutoDeployment,
 		MaxCallbackGas:             maxCallbackGas,
 		CroBridgeContractAddresses: croBridgeContractAddresses,
+		MaxRefundRetryGas:          maxRefundRetryGas,
+		DenomScalings:              denomScalings,
+		LogHandlerPolicies:         logHandlerPolicies,
+		StrictLogHandlers:          strictLogHandlers,
 	}
 }
 
@@ -58,6 +102,7 @@ func DefaultParams() Params {
 		CronosAdmin:          "",
 		EnableAutoDeployment: false,
 		MaxCallbackGas:       MaxCallbackGasDefaultValue,
+		MaxRefundRetryGas:    MaxRefundRetryGasDefaultValue,
 	}
 }
 
@@ -80,6 +125,26 @@ func (p Params) Validate() error {
 	if err := validateIsEvmAddresses(p.CroBridgeContractAddresses); err != nil {
 		return err
 	}
+	if err := validateIsUint64(p.MaxRefundRetryGas); err != nil {
+		return err
+	}
+	if err := validateDenomScalings(p.DenomScalings); err != nil {
+		return err
+	}
+	if err := validateLogHandlerPolicies(p.LogHandlerPolicies); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateDenomScalingTargets validates the denom scaling targets against the evm denom, only the ibc cro denom
+// can be scaled to the evm denom, so the other assets can't mint it and the reverse conversion is unambiguous.
+func (p Params) ValidateDenomScalingTargets(evmDenom string) error {
+	for _, scaling := range p.DenomScalings {
+		if scaling.TargetDenom == evmDenom && scaling.Denom != p.IbcCroDenom {
+			return fmt.Errorf("invalid denom scaling: only %s can be scaled to the evm denom %s", p.IbcCroDenom, evmDenom)
+		}
+	}
 	return nil
 }
 
@@ -98,6 +163,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEnableAutoDeployment, &p.EnableAutoDeployment, validateIsBool),
 		paramtypes.NewParamSetPair(KeyMaxCallbackGas, &p.MaxCallbackGas, validateIsUint64),
 		paramtypes.NewParamSetPair(KeyCroBridgeContractAddresses, &p.CroBridgeContractAddresses, validateIsEvmAddresses),
+		paramtypes.NewParamSetPair(KeyMaxRefundRetryGas, &p.MaxRefundRetryGas, validateIsUint64),
+		paramtypes.NewParamSetPair(KeyDenomScalings, &p.DenomScalings, validateDenomScalings),
+		paramtypes.NewParamSetPair(KeyLogHandlerPolicies, &p.LogHandlerPolicies, validateLogHandlerPolicies),
+		paramtypes.NewParamSetPair(KeyStrictLogHandlers, &p.StrictLogHandlers, validateIsBool),
 	}
 }
 
@@ -164,3 +233,63 @@ func validateIsEvmAddresses(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDenomScalings(i interface{}) error {
+	scalings, ok := i.([]DenomScaling)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	denoms := make(map[string]bool, len(scalings))
+	targets := make(map[string]bool, len(scalings))
+	for _, scaling := range scalings {
+		if err := sdk.ValidateDenom(scaling.Denom); err != nil {
+			return fmt.Errorf("invalid denom scaling: %w", err)
+		}
+		if err := sdk.ValidateDenom(scaling.TargetDenom); err != nil {
+			return fmt.Errorf("invalid denom scaling target: %w", err)
+		}
+		if scaling.Denom == scaling.TargetDenom {
+			return fmt.Errorf("invalid denom scaling: %s is scaled to itself", scaling.Denom)
+		}
+		// the target is minted by the module, it must not be a voucher of another chain
+		if strings.HasPrefix(scaling.TargetDenom, "ibc/") {
+			return fmt.Errorf("invalid denom scaling target: %s is an ibc voucher", scaling.TargetDenom)
+		}
+		if denoms[scaling.Denom] {
+			return fmt.Errorf("duplicated denom scaling: %s", scaling.Denom)
+		}
+		if targets[scaling.TargetDenom] {
+			return fmt.Errorf("duplicated denom scaling target: %s", scaling.TargetDenom)
+		}
+		denoms[scaling.Denom] = true
+		targets[scaling.TargetDenom] = true
+	}
+	return nil
+}
+
+func validateLogHandlerPolicies(i interface{}) error {
+	policies, ok := i.([]LogHandlerPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if !slices.Contains(LogHandlerEventNames, policy.EventName) {
+			return fmt.Errorf("invalid log handler policy: unknown event name %q", policy.EventName)
+		}
+		if seen[policy.EventName] {
+			return fmt.Errorf("duplicated log handler policy: %s", policy.EventName)
+		}
+		seen[policy.EventName] = true
+		if err := validateIsEvmAddresses(policy.AllowedContracts); err != nil {
+			return err
+		}
+		if err := validateIsEvmAddresses(policy.DeniedContracts); err != nil {
+			return err
+		}
+		if !policy.MaxAmountPerBlock.IsNil() && policy.MaxAmountPerBlock.IsNegative() {
+			return fmt.Errorf("invalid log handler policy %s: negative max amount per block", policy.EventName)
+		}
+	}
+	return nil
+}
@@ -132,3 +132,90 @@ func Test_ParamsValidateRejectsOversizedIbcTimeout(t *testing.T) {
 	params.IbcTimeout = IbcTimeoutDefaultValue
 	require.NoError(t, params.Validate())
 }
+
+func Test_validateDenomScalings(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"empty", args{[]DenomScaling{}}, false},
+		{"correct scaling", args{[]DenomScaling{{Denom: IbcCroDenomDefaultValue, TargetDenom: "basecro"}}}, false},
+		{"invalid denom", args{[]DenomScaling{{Denom: "", TargetDenom: "basecro"}}}, true},
+		{"invalid target denom", args{[]DenomScaling{{Denom: "uatom", TargetDenom: "1atom"}}}, true},
+		{"scaled to itself", args{[]DenomScaling{{Denom: "basecro", TargetDenom: "basecro"}}}, true},
+		{"duplicated denom", args{[]DenomScaling{
+			{Denom: "uatom", TargetDenom: "aatom"},
+			{Denom: "uatom", TargetDenom: "batom"},
+		}}, true},
+		{"duplicated target denom", args{[]DenomScaling{
+			{Denom: "uatom", TargetDenom: "aatom"},
+			{Denom: "natom", TargetDenom: "aatom"},
+		}}, true},
+		{"ibc voucher target denom", args{[]DenomScaling{{Denom: "uatom", TargetDenom: IbcCroDenomDefaultValue}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateDenomScalings(tt.args.i) != nil)
+		})
+	}
+}
+
+func TestParams_ValidateDenomScalingTargets(t *testing.T) {
+	tests := []struct {
+		name     string
+		scalings []DenomScaling
+		wantErr  bool
+	}{
+		{"no scaling", nil, false},
+		{"ibc cro denom to evm denom", []DenomScaling{{Denom: IbcCroDenomDefaultValue, TargetDenom: "basecro"}}, false},
+		{"other denom", []DenomScaling{{Denom: "uatom", TargetDenom: "aatom"}}, false},
+		{"other denom to evm denom", []DenomScaling{{Denom: "uatom", TargetDenom: "basecro"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.DenomScalings = tt.scalings
+			require.Equal(t, tt.wantErr, params.ValidateDenomScalingTargets("basecro") != nil)
+		})
+	}
+}
+
+func Test_validateLogHandlerPolicies(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	contract := "0x0000000000000000000000000000000000000101"
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"empty", args{[]LogHandlerPolicy{}}, false},
+		{"correct policy", args{[]LogHandlerPolicy{{
+			EventName:         "__CronosSendToIbc",
+			AllowedContracts:  []string{contract},
+			MaxAmountPerBlock: sdkmath.NewInt(100),
+		}}}, false},
+		{"nil max amount", args{[]LogHandlerPolicy{{EventName: "__CronosSendToIbc", DeniedContracts: []string{contract}}}}, false},
+		{"empty event name", args{[]LogHandlerPolicy{{AllowedContracts: []string{contract}}}}, true},
+		{"unknown event name", args{[]LogHandlerPolicy{{EventName: "Transfer", AllowedContracts: []string{contract}}}}, true},
+		{"log action policy", args{[]LogHandlerPolicy{{EventName: LogHandlerLogAction, DeniedContracts: []string{contract}}}}, false},
+		{"duplicated event name", args{[]LogHandlerPolicy{
+			{EventName: "__CronosSendToIbc"},
+			{EventName: "__CronosSendToIbc"},
+		}}, true},
+		{"invalid contract", args{[]LogHandlerPolicy{{EventName: "__CronosSendToIbc", DeniedContracts: []string{"0x1"}}}}, true},
+		{"negative max amount", args{[]LogHandlerPolicy{{EventName: "__CronosSendToIbc", MaxAmountPerBlock: sdkmath.NewInt(-1)}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateLogHandlerPolicies(tt.args.i) != nil)
+		})
+	}
+}
@@ -625,6 +625,11 @@ var xxx_messageInfo_MsgUpdatePermissionsResponse proto.InternalMessageInfo
 type MsgStoreBlockList struct {
 	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	Blob []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	// verify_coverage rejects the blob unless every bonded validator has a
+	// valid encryption key and the blob has an age stanza for each of them, the
+	// ssh keys are matched by the stanza tags, the native x25519, hybrid and
+	// plugin stanzas don't reveal their recipients and are only counted
+	VerifyCoverage bool `protobuf:"varint,3,opt,name=verify_coverage,json=verifyCoverage,proto3" json:"verify_coverage,omitempty"`
 }
 
 func (m *MsgStoreBlockList) Reset()         { *m = MsgStoreBlockList{} }
@@ -674,6 +679,13 @@ func (m *MsgStoreBlockList) GetBlob() []byte {
 	return nil
 }
 
+func (m *MsgStoreBlockList) GetVerifyCoverage() bool {
+	if m != nil {
+		return m.VerifyCoverage
+	}
+	return false
+}
+
 // MsgStoreBlockListResponse
 type MsgStoreBlockListResponse struct {
 }
@@ -711,6 +723,291 @@ func (m *MsgStoreBlockListResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStoreBlockListResponse proto.InternalMessageInfo
 
+// MsgClaimDust defines the request type for refunding the dust balances of an
+// address.
+type MsgClaimDust struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgClaimDust) Reset()         { *m = MsgClaimDust{} }
+func (m *MsgClaimDust) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDust) ProtoMessage()    {}
+func (*MsgClaimDust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{14}
+}
+func (m *MsgClaimDust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimDust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDust.Merge(m, src)
+}
+func (m *MsgClaimDust) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDust) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDust proto.InternalMessageInfo
+
+func (m *MsgClaimDust) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgClaimDustResponse defines the ClaimDust response type.
+type MsgClaimDustResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimDustResponse) Reset()         { *m = MsgClaimDustResponse{} }
+func (m *MsgClaimDustResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDustResponse) ProtoMessage()    {}
+func (*MsgClaimDustResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{15}
+}
+func (m *MsgClaimDustResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDustResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDustResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimDustResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDustResponse.Merge(m, src)
+}
+func (m *MsgClaimDustResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDustResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDustResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDustResponse proto.InternalMessageInfo
+
+func (m *MsgClaimDustResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetLogAction defines the request type for binding the logs of an event
+// emitted by a contract to a native message.
+type MsgSetLogAction struct {
+	// authority is the address of the governance account.
+	Authority string    `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Action    LogAction `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
+}
+
+func (m *MsgSetLogAction) Reset()         { *m = MsgSetLogAction{} }
+func (m *MsgSetLogAction) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLogAction) ProtoMessage()    {}
+func (*MsgSetLogAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{16}
+}
+func (m *MsgSetLogAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLogAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLogAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLogAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLogAction.Merge(m, src)
+}
+func (m *MsgSetLogAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLogAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLogAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLogAction proto.InternalMessageInfo
+
+func (m *MsgSetLogAction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetLogAction) GetAction() LogAction {
+	if m != nil {
+		return m.Action
+	}
+	return LogAction{}
+}
+
+// MsgSetLogActionResponse defines the response type.
+type MsgSetLogActionResponse struct {
+}
+
+func (m *MsgSetLogActionResponse) Reset()         { *m = MsgSetLogActionResponse{} }
+func (m *MsgSetLogActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLogActionResponse) ProtoMessage()    {}
+func (*MsgSetLogActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{17}
+}
+func (m *MsgSetLogActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLogActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLogActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLogActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLogActionResponse.Merge(m, src)
+}
+func (m *MsgSetLogActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLogActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLogActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLogActionResponse proto.InternalMessageInfo
+
+// MsgDeleteLogAction defines the request type for removing a native action
+// bound to evm logs.
+type MsgDeleteLogAction struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the hex address of the contract emitting the event
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the hex signature hash of the event
+	EventId string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+}
+
+func (m *MsgDeleteLogAction) Reset()         { *m = MsgDeleteLogAction{} }
+func (m *MsgDeleteLogAction) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteLogAction) ProtoMessage()    {}
+func (*MsgDeleteLogAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{18}
+}
+func (m *MsgDeleteLogAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteLogAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteLogAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteLogAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteLogAction.Merge(m, src)
+}
+func (m *MsgDeleteLogAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteLogAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteLogAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteLogAction proto.InternalMessageInfo
+
+func (m *MsgDeleteLogAction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeleteLogAction) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgDeleteLogAction) GetEventId() string {
+	if m != nil {
+		return m.EventId
+	}
+	return ""
+}
+
+// MsgDeleteLogActionResponse defines the response type.
+type MsgDeleteLogActionResponse struct {
+}
+
+func (m *MsgDeleteLogActionResponse) Reset()         { *m = MsgDeleteLogActionResponse{} }
+func (m *MsgDeleteLogActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteLogActionResponse) ProtoMessage()    {}
+func (*MsgDeleteLogActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28e09e4eabb18884, []int{19}
+}
+func (m *MsgDeleteLogActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteLogActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteLogActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteLogActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteLogActionResponse.Merge(m, src)
+}
+func (m *MsgDeleteLogActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteLogActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteLogActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteLogActionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertVouchers)(nil), "cronos.MsgConvertVouchers")
 	proto.RegisterType((*MsgTransferTokens)(nil), "cronos.MsgTransferTokens")
@@ -726,61 +1023,79 @@ func init() {
 	proto.RegisterType((*MsgUpdatePermissionsResponse)(nil), "cronos.MsgUpdatePermissionsResponse")
 	proto.RegisterType((*MsgStoreBlockList)(nil), "cronos.MsgStoreBlockList")
 	proto.RegisterType((*MsgStoreBlockListResponse)(nil), "cronos.MsgStoreBlockListResponse")
+	proto.RegisterType((*MsgClaimDust)(nil), "cronos.MsgClaimDust")
+	proto.RegisterType((*MsgClaimDustResponse)(nil), "cronos.MsgClaimDustResponse")
+	proto.RegisterType((*MsgSetLogAction)(nil), "cronos.MsgSetLogAction")
+	proto.RegisterType((*MsgSetLogActionResponse)(nil), "cronos.MsgSetLogActionResponse")
+	proto.RegisterType((*MsgDeleteLogAction)(nil), "cronos.MsgDeleteLogAction")
+	proto.RegisterType((*MsgDeleteLogActionResponse)(nil), "cronos.MsgDeleteLogActionResponse")
 }
 
 func init() { proto.RegisterFile("cronos/tx.proto", fileDescriptor_28e09e4eabb18884) }
 
 var fileDescriptor_28e09e4eabb18884 = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xeb, 0x44,
-	0x10, 0x8f, 0xf3, 0x8f, 0xd7, 0x49, 0x9b, 0xea, 0x2d, 0x7d, 0xaf, 0x8e, 0x5f, 0xea, 0x04, 0x0b,
-	0xa4, 0xe8, 0x89, 0xc6, 0xa4, 0xdc, 0x72, 0x4c, 0x25, 0x40, 0x82, 0x54, 0x60, 0x0a, 0x48, 0xbd,
-	0xf9, 0xcf, 0xd6, 0xb1, 0x1a, 0x7b, 0xcd, 0xee, 0xa6, 0x6a, 0x6e, 0x88, 0x13, 0x47, 0xbe, 0x01,
-	0x5c, 0xb8, 0x70, 0xea, 0xb7, 0xa0, 0xc7, 0x1e, 0x39, 0x01, 0x6a, 0x0f, 0xfd, 0x1a, 0xc8, 0xeb,
-	0xb5, 0xe3, 0xfc, 0xeb, 0xed, 0x9d, 0xbc, 0x33, 0xbf, 0x9d, 0xf9, 0xfd, 0xc6, 0x33, 0x63, 0xc3,
-	0xbe, 0x4b, 0x49, 0x44, 0x98, 0xc9, 0x6f, 0xfa, 0x31, 0x25, 0x9c, 0xa0, 0x7a, 0xea, 0xd0, 0x0e,
-	0x5d, 0xc2, 0x42, 0xc2, 0xcc, 0x90, 0xf9, 0xe6, 0xf5, 0x20, 0x79, 0xa4, 0x17, 0xb4, 0x03, 0x9f,
-	0xf8, 0x44, 0x1c, 0xcd, 0xe4, 0x24, 0xbd, 0xba, 0xbc, 0xee, 0xd8, 0x0c, 0x9b, 0xd7, 0x03, 0x07,
-	0x73, 0x7b, 0x60, 0xba, 0x24, 0x88, 0x24, 0xfe, 0xbe, 0xe4, 0x49, 0x1f, 0xa9, 0xd3, 0xf8, 0x4d,
-	0x01, 0x34, 0x66, 0xfe, 0x29, 0x89, 0xae, 0x31, 0xe5, 0xdf, 0x93, 0x99, 0x3b, 0xc1, 0x94, 0x21,
-	0x15, 0xde, 0xb3, 0x3d, 0x8f, 0x62, 0xc6, 0x54, 0xa5, 0xab, 0xf4, 0x76, 0xac, 0xcc, 0x44, 0x36,
-	0xd4, 0x92, 0x9c, 0x4c, 0x2d, 0x77, 0x2b, 0xbd, 0xc6, 0x49, 0xab, 0x9f, 0xb2, 0xf6, 0x13, 0xd6,
-	0xbe, 0x64, 0xed, 0x9f, 0x92, 0x20, 0x1a, 0x7d, 0x72, 0xf7, 0x4f, 0xa7, 0xf4, 0xe7, 0xbf, 0x9d,
-	0x9e, 0x1f, 0xf0, 0xc9, 0xcc, 0xe9, 0xbb, 0x24, 0x34, 0xa5, 0xc4, 0xf4, 0x71, 0xcc, 0xbc, 0x2b,
-	0x93, 0xcf, 0x63, 0xcc, 0x44, 0x00, 0xb3, 0xd2, 0xcc, 0xc3, 0xdd, 0x9f, 0x9f, 0x6e, 0xdf, 0x66,
-	0x84, 0xc6, 0x1f, 0x0a, 0xbc, 0x1c, 0x33, 0xff, 0x9c, 0xda, 0x11, 0xbb, 0xc4, 0xf4, 0x9c, 0x5c,
-	0xe1, 0x88, 0x21, 0x04, 0xd5, 0x4b, 0x4a, 0x42, 0xa9, 0x4e, 0x9c, 0x51, 0x13, 0xca, 0x9c, 0xa8,
-	0x65, 0xe1, 0x29, 0x73, 0xb2, 0x90, 0x5a, 0x79, 0x67, 0x52, 0x77, 0x12, 0xa9, 0x82, 0xdd, 0x68,
-	0x83, 0xb6, 0xfe, 0x22, 0x2d, 0xcc, 0x62, 0x12, 0x31, 0x6c, 0xbc, 0x81, 0xd6, 0x5a, 0x11, 0x39,
-	0xf8, 0xbb, 0x02, 0xaf, 0xc6, 0xcc, 0xff, 0x2e, 0xf6, 0x6c, 0x8e, 0x05, 0x36, 0xb6, 0xe3, 0x38,
-	0x88, 0x7c, 0xf4, 0x1a, 0xea, 0x0c, 0x47, 0x1e, 0xa6, 0xb2, 0x50, 0x69, 0xa1, 0x03, 0xa8, 0x79,
-	0x38, 0x22, 0xa1, 0xac, 0x36, 0x35, 0x90, 0x06, 0x2f, 0x5c, 0x12, 0x71, 0x6a, 0xbb, 0x5c, 0xad,
-	0x08, 0x20, 0xb7, 0x45, 0xa6, 0x79, 0xe8, 0x90, 0xa9, 0x5a, 0x95, 0x99, 0x84, 0x95, 0x74, 0xda,
-	0xc3, 0x6e, 0x10, 0xda, 0x53, 0xb5, 0xd6, 0x55, 0x7a, 0x7b, 0x56, 0x66, 0x0e, 0x1b, 0x49, 0x6d,
-	0x92, 0xd0, 0xe8, 0xc0, 0xd1, 0x46, 0x85, 0x79, 0x0d, 0x67, 0xb0, 0x97, 0x14, 0x38, 0xa3, 0xd1,
-	0x88, 0x06, 0x9e, 0x8f, 0xb7, 0x4a, 0x7f, 0x0d, 0x75, 0x1c, 0xd9, 0xce, 0x14, 0x0b, 0xed, 0x2f,
-	0x2c, 0x69, 0x0d, 0xf7, 0x0a, 0x74, 0xaa, 0x62, 0xbc, 0x11, 0xaf, 0x64, 0x91, 0x2f, 0x23, 0x1a,
-	0x96, 0x55, 0xc5, 0x08, 0x61, 0x3f, 0x57, 0xf3, 0xb5, 0x4d, 0xed, 0x90, 0xa1, 0x36, 0xec, 0xd8,
-	0x33, 0x3e, 0x21, 0x34, 0xe0, 0x73, 0xc9, 0xb8, 0x70, 0xa0, 0x8f, 0xa1, 0x1e, 0x8b, 0x7b, 0x82,
-	0xb4, 0x71, 0xd2, 0xec, 0xcb, 0x2d, 0x48, 0xa3, 0x47, 0xd5, 0x64, 0x00, 0x2c, 0x79, 0x67, 0xd8,
-	0x4c, 0xa4, 0x2c, 0xa2, 0x8d, 0x16, 0x1c, 0xae, 0xd0, 0xe5, 0x65, 0xff, 0x08, 0x07, 0x0b, 0x08,
-	0xd3, 0x30, 0x60, 0x2c, 0x20, 0x5b, 0xe6, 0xb3, 0xb0, 0x54, 0xe5, 0xe5, 0xa5, 0xea, 0x42, 0x23,
-	0x5e, 0x04, 0x8b, 0xde, 0x55, 0xad, 0xa2, 0xab, 0x38, 0x68, 0x3a, 0xb4, 0x37, 0x51, 0xe6, 0x92,
-	0x3e, 0x13, 0xfb, 0xf2, 0x2d, 0x27, 0x14, 0x8f, 0xa6, 0xc4, 0xbd, 0xfa, 0x2a, 0x60, 0x7c, 0xa3,
-	0x1e, 0x04, 0x55, 0x67, 0x4a, 0x1c, 0x21, 0x66, 0xd7, 0x12, 0xe7, 0x22, 0x4f, 0x3a, 0xb2, 0xcb,
-	0x79, 0x32, 0x92, 0x93, 0xbf, 0xaa, 0x50, 0x19, 0x33, 0x1f, 0x7d, 0x03, 0xfb, 0xab, 0xdf, 0x0e,
-	0x2d, 0x7b, 0xb7, 0xeb, 0xeb, 0xa0, 0x19, 0xdb, 0xb1, 0x2c, 0x35, 0x3a, 0x83, 0xe6, 0xca, 0xb2,
-	0xb7, 0x0a, 0x51, 0xcb, 0x90, 0xf6, 0xc1, 0x56, 0x28, 0xcf, 0x77, 0x01, 0x68, 0xc3, 0x66, 0x1d,
-	0x15, 0x02, 0xd7, 0x61, 0xed, 0xa3, 0x67, 0xe1, 0x3c, 0xf7, 0xe7, 0x00, 0x85, 0x91, 0x7f, 0x55,
-	0x14, 0x93, 0xbb, 0xb5, 0xa3, 0x8d, 0xee, 0xbc, 0x5f, 0x95, 0x5f, 0xca, 0x0a, 0xfa, 0x02, 0x76,
-	0x97, 0xc6, 0xf9, 0x70, 0x8d, 0x3f, 0x05, 0xb4, 0xce, 0x16, 0x20, 0x97, 0xf4, 0x03, 0xbc, 0x5c,
-	0x1f, 0xc7, 0xf6, 0x7a, 0xd4, 0x02, 0xd5, 0x3e, 0x7c, 0x0e, 0x2d, 0xf6, 0x65, 0x65, 0xa8, 0x8a,
-	0x7d, 0x59, 0x86, 0x96, 0xfa, 0xb2, 0x79, 0x84, 0xb4, 0xda, 0x4f, 0x4f, 0xb7, 0x6f, 0x95, 0xd1,
-	0x97, 0x77, 0x0f, 0xba, 0x72, 0xff, 0xa0, 0x2b, 0xff, 0x3d, 0xe8, 0xca, 0xaf, 0x8f, 0x7a, 0xe9,
-	0xfe, 0x51, 0x2f, 0xfd, 0xfd, 0xa8, 0x97, 0x2e, 0x06, 0xc5, 0xaf, 0x31, 0x9d, 0xc7, 0x9c, 0x1c,
-	0x13, 0xea, 0x1f, 0xbb, 0x13, 0x3b, 0x88, 0xe4, 0x5f, 0xcc, 0xbc, 0xc9, 0x0e, 0xe2, 0xe3, 0xec,
-	0xd4, 0xc5, 0x5f, 0xed, 0xd3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x0c, 0x6a, 0xe3, 0x54,
-	0x07, 0x00, 0x00,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xb1, 0x6f, 0xdb, 0xc6,
+	0x17, 0x36, 0x25, 0x59, 0xb1, 0x9f, 0x65, 0x09, 0xe6, 0x4f, 0x8e, 0x29, 0x46, 0x96, 0x1c, 0xe2,
+	0x57, 0xd4, 0x08, 0x6a, 0xb1, 0x76, 0x81, 0x0e, 0x5a, 0x8a, 0xca, 0x01, 0x9a, 0xa2, 0x51, 0xd0,
+	0x28, 0x69, 0x0b, 0x64, 0x09, 0x28, 0xf2, 0x4c, 0x13, 0x16, 0x79, 0xec, 0xdd, 0x49, 0x88, 0xd0,
+	0xa5, 0xe8, 0xd4, 0xb1, 0xff, 0x41, 0xbb, 0x64, 0xe9, 0x94, 0x3f, 0x23, 0x63, 0xc6, 0x4e, 0x6d,
+	0x61, 0x0f, 0xf9, 0x37, 0x0a, 0x1e, 0x8f, 0xa7, 0x13, 0x25, 0xa6, 0x1d, 0xda, 0x89, 0xbc, 0xf7,
+	0xdd, 0xbd, 0xef, 0x7b, 0xbc, 0xf7, 0x3e, 0x09, 0x1a, 0x2e, 0xc1, 0x11, 0xa6, 0x36, 0x7b, 0xd1,
+	0x8b, 0x09, 0x66, 0x58, 0xaf, 0xa6, 0x01, 0xf3, 0xc0, 0xc5, 0x34, 0xc4, 0xd4, 0x0e, 0xa9, 0x6f,
+	0xcf, 0x4e, 0x93, 0x47, 0xba, 0xc1, 0x6c, 0xfa, 0xd8, 0xc7, 0xfc, 0xd5, 0x4e, 0xde, 0x44, 0xb4,
+	0x23, 0xb6, 0x8f, 0x1d, 0x8a, 0xec, 0xd9, 0xe9, 0x18, 0x31, 0xe7, 0xd4, 0x76, 0x71, 0x10, 0x09,
+	0xfc, 0x7f, 0x82, 0x27, 0x7d, 0xa4, 0x41, 0xeb, 0x67, 0x0d, 0xf4, 0x21, 0xf5, 0xcf, 0x71, 0x34,
+	0x43, 0x84, 0x7d, 0x8d, 0xa7, 0xee, 0x25, 0x22, 0x54, 0x37, 0xe0, 0x96, 0xe3, 0x79, 0x04, 0x51,
+	0x6a, 0x68, 0x47, 0xda, 0xf1, 0xf6, 0x28, 0x5b, 0xea, 0x0e, 0x6c, 0x26, 0x39, 0xa9, 0x51, 0x3a,
+	0x2a, 0x1f, 0xef, 0x9c, 0xb5, 0x7a, 0x29, 0x6b, 0x2f, 0x61, 0xed, 0x09, 0xd6, 0xde, 0x39, 0x0e,
+	0xa2, 0xc1, 0x87, 0xaf, 0x7f, 0xef, 0x6e, 0xfc, 0xfa, 0x47, 0xf7, 0xd8, 0x0f, 0xd8, 0xe5, 0x74,
+	0xdc, 0x73, 0x71, 0x68, 0x0b, 0x89, 0xe9, 0xe3, 0x84, 0x7a, 0x57, 0x36, 0x9b, 0xc7, 0x88, 0xf2,
+	0x03, 0x74, 0x94, 0x66, 0xee, 0xd7, 0x7e, 0x78, 0xfb, 0xea, 0x5e, 0x46, 0x68, 0xbd, 0xd4, 0x60,
+	0x6f, 0x48, 0xfd, 0xa7, 0xc4, 0x89, 0xe8, 0x05, 0x22, 0x4f, 0xf1, 0x15, 0x8a, 0xa8, 0xae, 0x43,
+	0xe5, 0x82, 0xe0, 0x50, 0xa8, 0xe3, 0xef, 0x7a, 0x1d, 0x4a, 0x0c, 0x1b, 0x25, 0x1e, 0x29, 0x31,
+	0xbc, 0x90, 0x5a, 0xfe, 0xcf, 0xa4, 0x6e, 0x27, 0x52, 0x39, 0xbb, 0xd5, 0x06, 0x73, 0xf5, 0x43,
+	0x8e, 0x10, 0x8d, 0x71, 0x44, 0x91, 0x75, 0x07, 0x5a, 0x2b, 0x45, 0x48, 0xf0, 0x17, 0x0d, 0xf6,
+	0x87, 0xd4, 0xff, 0x2a, 0xf6, 0x1c, 0x86, 0x38, 0x36, 0x74, 0xe2, 0x38, 0x88, 0x7c, 0xfd, 0x36,
+	0x54, 0x29, 0x8a, 0x3c, 0x44, 0x44, 0xa1, 0x62, 0xa5, 0x37, 0x61, 0xd3, 0x43, 0x11, 0x0e, 0x45,
+	0xb5, 0xe9, 0x42, 0x37, 0x61, 0xcb, 0xc5, 0x11, 0x23, 0x8e, 0xcb, 0x8c, 0x32, 0x07, 0xe4, 0x9a,
+	0x67, 0x9a, 0x87, 0x63, 0x3c, 0x31, 0x2a, 0x22, 0x13, 0x5f, 0x25, 0x37, 0xed, 0x21, 0x37, 0x08,
+	0x9d, 0x89, 0xb1, 0x79, 0xa4, 0x1d, 0xef, 0x8e, 0xb2, 0x65, 0x7f, 0x27, 0xa9, 0x4d, 0x10, 0x5a,
+	0x5d, 0x38, 0x5c, 0xab, 0x50, 0xd6, 0xf0, 0x08, 0x76, 0x93, 0x02, 0xa7, 0x24, 0x1a, 0x90, 0xc0,
+	0xf3, 0x51, 0xa1, 0xf4, 0xdb, 0x50, 0x45, 0x91, 0x33, 0x9e, 0x20, 0xae, 0x7d, 0x6b, 0x24, 0x56,
+	0xfd, 0x5d, 0x85, 0xce, 0xd0, 0xac, 0x3b, 0xb0, 0xbf, 0x94, 0x2f, 0x23, 0xea, 0x97, 0x0c, 0xcd,
+	0x0a, 0xa1, 0x21, 0xd5, 0x7c, 0xe9, 0x10, 0x27, 0xa4, 0x7a, 0x1b, 0xb6, 0x9d, 0x29, 0xbb, 0xc4,
+	0x24, 0x60, 0x73, 0xc1, 0xb8, 0x08, 0xe8, 0x1f, 0x40, 0x35, 0xe6, 0xfb, 0x38, 0xe9, 0xce, 0x59,
+	0xbd, 0x27, 0xa6, 0x20, 0x3d, 0x3d, 0xa8, 0x24, 0x0d, 0x30, 0x12, 0x7b, 0xfa, 0xf5, 0x44, 0xca,
+	0xe2, 0xb4, 0xd5, 0x82, 0x83, 0x1c, 0x9d, 0x2c, 0xfb, 0x5b, 0x68, 0x2e, 0x20, 0x44, 0xc2, 0x80,
+	0xd2, 0x00, 0x17, 0xf4, 0xa7, 0x32, 0x54, 0xa5, 0xe5, 0xa1, 0x3a, 0x82, 0x9d, 0x78, 0x71, 0x98,
+	0xdf, 0x5d, 0x65, 0xa4, 0x86, 0xd4, 0x46, 0xeb, 0x40, 0x7b, 0x1d, 0xa5, 0x94, 0x84, 0xf9, 0xbc,
+	0x3c, 0x61, 0x98, 0xa0, 0xc1, 0x04, 0xbb, 0x57, 0x0f, 0x03, 0xca, 0xd6, 0xea, 0xd1, 0xa1, 0x32,
+	0x9e, 0xe0, 0x31, 0x17, 0x53, 0x1b, 0xf1, 0x77, 0xfd, 0x7d, 0x68, 0xcc, 0x10, 0x09, 0x2e, 0xe6,
+	0xcf, 0x5d, 0x3c, 0x43, 0xc4, 0xf1, 0x11, 0x57, 0xb3, 0x35, 0xaa, 0xa7, 0xe1, 0x73, 0x11, 0x55,
+	0x05, 0xa5, 0xbd, 0xbd, 0x4c, 0x28, 0xd5, 0x7c, 0x0c, 0xb5, 0x64, 0x2c, 0x26, 0x4e, 0x10, 0xde,
+	0x9f, 0x52, 0x56, 0xec, 0x2c, 0xb9, 0xb1, 0xff, 0x0e, 0x9a, 0xea, 0xb9, 0x2c, 0x9f, 0xee, 0x42,
+	0xd5, 0x09, 0xf1, 0x34, 0x62, 0x86, 0xf6, 0xef, 0x4f, 0xb5, 0x48, 0x6d, 0xc5, 0xbc, 0xbf, 0x9e,
+	0x20, 0xf6, 0x10, 0xfb, 0x9f, 0xba, 0x2c, 0xc0, 0xd1, 0xdf, 0xf4, 0x97, 0x0d, 0x55, 0x87, 0xef,
+	0x13, 0xfd, 0xb5, 0x97, 0xf5, 0x97, 0x4c, 0x90, 0xb5, 0x58, 0xba, 0xad, 0xa0, 0xc5, 0x54, 0x46,
+	0xf9, 0x05, 0xe7, 0xdc, 0xa1, 0xef, 0xa3, 0x09, 0x62, 0xe8, 0x9f, 0xea, 0x51, 0x9d, 0xa0, 0x94,
+	0x73, 0x82, 0x16, 0x6c, 0xa1, 0x19, 0x8a, 0xd8, 0xf3, 0xc0, 0x13, 0x2e, 0x71, 0x8b, 0xaf, 0x3f,
+	0xf7, 0x56, 0x54, 0xa5, 0x9e, 0x96, 0xa3, 0xce, 0x84, 0x9d, 0xbd, 0xac, 0x42, 0x79, 0x48, 0x7d,
+	0xfd, 0x31, 0x34, 0xf2, 0xbf, 0x1f, 0x66, 0x56, 0xff, 0xaa, 0x25, 0x9a, 0x56, 0x31, 0x26, 0x6f,
+	0xf9, 0x11, 0xd4, 0x73, 0x86, 0xdf, 0x52, 0x4e, 0x2d, 0x43, 0xe6, 0xdd, 0x42, 0x48, 0xe6, 0x7b,
+	0x06, 0xfa, 0x1a, 0x77, 0x3d, 0x54, 0x0e, 0xae, 0xc2, 0xe6, 0x7b, 0xef, 0x84, 0x65, 0xee, 0xcf,
+	0x00, 0x14, 0xdb, 0xdb, 0x57, 0xc5, 0xc8, 0xb0, 0x79, 0xb8, 0x36, 0x2c, 0xef, 0xb8, 0xfc, 0x63,
+	0x49, 0xd3, 0x1f, 0x40, 0x6d, 0xc9, 0xd2, 0x0e, 0x56, 0xf8, 0x53, 0xc0, 0xec, 0x16, 0x00, 0x52,
+	0xd2, 0x37, 0xb0, 0xb7, 0x6a, 0x49, 0xed, 0xd5, 0x53, 0x0b, 0xd4, 0xfc, 0xff, 0xbb, 0x50, 0xf5,
+	0x5e, 0x72, 0xc6, 0xa2, 0xde, 0xcb, 0x32, 0x64, 0xde, 0x2d, 0x84, 0x64, 0xbe, 0x4f, 0x60, 0x7b,
+	0x61, 0x0d, 0x4d, 0xb5, 0x31, 0xb2, 0xa8, 0xd9, 0x5e, 0x17, 0x95, 0x09, 0x1e, 0x40, 0x6d, 0x69,
+	0x4c, 0xd5, 0x6f, 0xa6, 0x02, 0x66, 0xb7, 0x00, 0x90, 0x99, 0x1e, 0x43, 0x23, 0x3f, 0x63, 0x6a,
+	0x17, 0xe7, 0x30, 0xd3, 0x2a, 0xc6, 0xb2, 0x94, 0xe6, 0xe6, 0xf7, 0x6f, 0x5f, 0xdd, 0xd3, 0x06,
+	0x5f, 0xbc, 0xbe, 0xee, 0x68, 0x6f, 0xae, 0x3b, 0xda, 0x9f, 0xd7, 0x1d, 0xed, 0xa7, 0x9b, 0xce,
+	0xc6, 0x9b, 0x9b, 0xce, 0xc6, 0x6f, 0x37, 0x9d, 0x8d, 0x67, 0xa7, 0xaa, 0x33, 0x91, 0x79, 0xcc,
+	0xf0, 0x09, 0x26, 0xfe, 0x89, 0x7b, 0xe9, 0x04, 0x91, 0xf8, 0x9f, 0x66, 0xbf, 0xc8, 0x5e, 0xb8,
+	0x51, 0x8d, 0xab, 0xfc, 0x7f, 0xdb, 0x47, 0x7f, 0x0d, 0x00, 0x9b, 0x5b, 0x9a, 0x49, 0x36, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -811,6 +1126,12 @@ type MsgClient interface {
 	UpdatePermissions(ctx context.Context, in *MsgUpdatePermissions, opts ...grpc.CallOption) (*MsgUpdatePermissionsResponse, error)
 	// StoreBlockList
 	StoreBlockList(ctx context.Context, in *MsgStoreBlockList, opts ...grpc.CallOption) (*MsgStoreBlockListResponse, error)
+	// ClaimDust defines a method to refund the dust balances of an address
+	ClaimDust(ctx context.Context, in *MsgClaimDust, opts ...grpc.CallOption) (*MsgClaimDustResponse, error)
+	// SetLogAction defines a method to bind the logs of an event emitted by a contract to a native message
+	SetLogAction(ctx context.Context, in *MsgSetLogAction, opts ...grpc.CallOption) (*MsgSetLogActionResponse, error)
+	// DeleteLogAction defines a method to remove a native action bound to evm logs
+	DeleteLogAction(ctx context.Context, in *MsgDeleteLogAction, opts ...grpc.CallOption) (*MsgDeleteLogActionResponse, error)
 }
 
 type msgClient struct {
@@ -885,6 +1206,33 @@ func (c *msgClient) StoreBlockList(ctx context.Context, in *MsgStoreBlockList, o
 	return out, nil
 }
 
+func (c *msgClient) ClaimDust(ctx context.Context, in *MsgClaimDust, opts ...grpc.CallOption) (*MsgClaimDustResponse, error) {
+	out := new(MsgClaimDustResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Msg/ClaimDust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLogAction(ctx context.Context, in *MsgSetLogAction, opts ...grpc.CallOption) (*MsgSetLogActionResponse, error) {
+	out := new(MsgSetLogActionResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Msg/SetLogAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteLogAction(ctx context.Context, in *MsgDeleteLogAction, opts ...grpc.CallOption) (*MsgDeleteLogActionResponse, error) {
+	out := new(MsgDeleteLogActionResponse)
+	err := c.cc.Invoke(ctx, "/cronos.Msg/DeleteLogAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertVouchers defines a method for converting ibc voucher to cronos evm
@@ -903,6 +1251,12 @@ type MsgServer interface {
 	UpdatePermissions(context.Context, *MsgUpdatePermissions) (*MsgUpdatePermissionsResponse, error)
 	// StoreBlockList
 	StoreBlockList(context.Context, *MsgStoreBlockList) (*MsgStoreBlockListResponse, error)
+	// ClaimDust defines a method to refund the dust balances of an address
+	ClaimDust(context.Context, *MsgClaimDust) (*MsgClaimDustResponse, error)
+	// SetLogAction defines a method to bind the logs of an event emitted by a contract to a native message
+	SetLogAction(context.Context, *MsgSetLogAction) (*MsgSetLogActionResponse, error)
+	// DeleteLogAction defines a method to remove a native action bound to evm logs
+	DeleteLogAction(context.Context, *MsgDeleteLogAction) (*MsgDeleteLogActionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -930,6 +1284,15 @@ func (*UnimplementedMsgServer) UpdatePermissions(ctx context.Context, req *MsgUp
 func (*UnimplementedMsgServer) StoreBlockList(ctx context.Context, req *MsgStoreBlockList) (*MsgStoreBlockListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreBlockList not implemented")
 }
+func (*UnimplementedMsgServer) ClaimDust(ctx context.Context, req *MsgClaimDust) (*MsgClaimDustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDust not implemented")
+}
+func (*UnimplementedMsgServer) SetLogAction(ctx context.Context, req *MsgSetLogAction) (*MsgSetLogActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogAction not implemented")
+}
+func (*UnimplementedMsgServer) DeleteLogAction(ctx context.Context, req *MsgDeleteLogAction) (*MsgDeleteLogActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogAction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1061,6 +1424,60 @@ func _Msg_StoreBlockList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimDust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDust)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Msg/ClaimDust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDust(ctx, req.(*MsgClaimDust))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLogAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLogAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLogAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Msg/SetLogAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLogAction(ctx, req.(*MsgSetLogAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteLogAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteLogAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteLogAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronos.Msg/DeleteLogAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteLogAction(ctx, req.(*MsgDeleteLogAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cronos.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1093,6 +1510,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StoreBlockList",
 			Handler:    _Msg_StoreBlockList_Handler,
 		},
+		{
+			MethodName: "ClaimDust",
+			Handler:    _Msg_ClaimDust_Handler,
+		},
+		{
+			MethodName: "SetLogAction",
+			Handler:    _Msg_SetLogAction_Handler,
+		},
+		{
+			MethodName: "DeleteLogAction",
+			Handler:    _Msg_DeleteLogAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cronos/tx.proto",
@@ -1529,6 +1958,16 @@ func (m *MsgStoreBlockList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VerifyCoverage {
+		i--
+		if m.VerifyCoverage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Blob) > 0 {
 		i -= len(m.Blob)
 		copy(dAtA[i:], m.Blob)
@@ -1569,16 +2008,213 @@ func (m *MsgStoreBlockListResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgClaimDust) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDust) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDustResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDustResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDustResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLogAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLogAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLogAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLogActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLogActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLogActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteLogAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteLogAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteLogAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventId) > 0 {
+		i -= len(m.EventId)
+		copy(dAtA[i:], m.EventId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteLogActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteLogActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteLogActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgConvertVouchers) Size() (n int) {
 	if m == nil {
@@ -1769,6 +2405,9 @@ func (m *MsgStoreBlockList) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.VerifyCoverage {
+		n += 2
+	}
 	return n
 }
 
@@ -1781,6 +2420,88 @@ func (m *MsgStoreBlockListResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimDust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDustResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetLogAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Action.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetLogActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteLogAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EventId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteLogActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2993,8 +3714,28 @@ func (m *MsgStoreBlockList) Unmarshal(dAtA []byte) error {
 				m.Blob = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyCoverage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyCoverage = bool(v != 0)
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -3064,6 +3805,533 @@ func (m *MsgStoreBlockListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimDust) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDust: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDust: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDustResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDustResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLogAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLogAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLogAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLogActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLogActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLogActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteLogAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteLogAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteLogAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteLogActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteLogActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteLogActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
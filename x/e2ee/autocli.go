@@ -22,6 +22,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query a batch of encryption key by addresses",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
 				},
+				{
+					RpcMethod:      "KeyHistory",
+					Use:            "key-history [address]",
+					Short:          "Query the registered encryption keys of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ValidatorsCoverage",
+					Use:       "validators-coverage",
+					Short:     "Query the bonded validators without a valid encryption key",
+				},
+				{
+					RpcMethod:      "Inbox",
+					Use:            "inbox [address]",
+					Short:          "Query the encrypted messages sent to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the parameters of the e2ee module",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -35,6 +57,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "key"},
 					},
 				},
+				{
+					RpcMethod: "RevokeEncryptionKey",
+					Use:       "revoke-encryption-key [key]",
+					Short:     "Revoke a registered encryption key of the user address, e.g. when it's compromised.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "key"},
+					},
+				},
+				{
+					RpcMethod: "SendEncrypted",
+					Skip:      true, // the payload is encrypted by the custom command
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 		},
 	}
@@ -1,6 +1,12 @@
 package cli
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"os"
+
+	"filippo.io/age/plugin"
+	"github.com/spf13/cobra"
+)
 
 func E2EECommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -13,8 +19,23 @@ func E2EECommand() *cobra.Command {
 		EncryptCommand(),
 		DecryptCommand(),
 		EncryptToValidatorsCommand(),
+		ValidatorsCoverageCommand(),
+		InboxCommand(),
+		ReloadIdentityCommand(),
 		PubKeyCommand(),
+		KeyServerCommand(),
+		ExportKeysCommand(),
+		ImportKeysCommand(),
 	)
 
 	return cmd
 }
+
+// pluginUI interacts with the user through the terminal for the age plugins, the messages are written to stderr
+// to keep the output clean.
+func pluginUI() *plugin.ClientUI {
+	printf := func(format string, v ...any) {
+		fmt.Fprintf(os.Stderr, format+"\n", v...)
+	}
+	return plugin.NewTerminalUI(printf, printf)
+}
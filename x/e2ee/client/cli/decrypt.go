@@ -46,6 +46,7 @@ func DecryptCommand() *cobra.Command {
 				return fmt.Errorf("no identity provided")
 			}
 
+			ui := pluginUI()
 			identities := make([]age.Identity, len(identityNames))
 			for i, name := range identityNames {
 				secret, err := kr.Get(name)
@@ -53,7 +54,7 @@ func DecryptCommand() *cobra.Command {
 					return err
 				}
 
-				identity, err := age.ParseX25519Identity(string(secret))
+				identity, err := keyring.ParseIdentity(secret, ui)
 				if err != nil {
 					return err
 				}
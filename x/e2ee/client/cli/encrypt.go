@@ -47,9 +47,10 @@ func EncryptCommand() *cobra.Command {
 				return err
 			}
 
+			ui := pluginUI()
 			recipients := make([]age.Recipient, len(recs))
 			for i, key := range rsp.Keys {
-				recipient, err := age.ParseX25519Recipient(key)
+				recipient, _, err := types.ParseRecipient(key, ui)
 				if err != nil {
 					return err
 				}
@@ -1,19 +1,27 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 
 	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/threshold"
 	"github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// FlagAllowUncovered encrypts to the covered validators even if some bonded validators lack a valid key.
+	FlagAllowUncovered = "allow-uncovered"
+	// FlagThreshold encrypts the block list t-of-n to the validators.
+	FlagThreshold = "threshold"
 )
 
 func EncryptToValidatorsCommand() *cobra.Command {
@@ -32,47 +40,41 @@ func EncryptToValidatorsCommand() *cobra.Command {
 				return err
 			}
 
-			ctx := context.Background()
-
-			// get validator list
-			stakingClient := stakingtypes.NewQueryClient(clientCtx)
-			valsRsp, err := stakingClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
-				Status: stakingtypes.BondStatusBonded,
-			})
+			allowUncovered, err := cmd.Flags().GetBool(FlagAllowUncovered)
 			if err != nil {
 				return err
 			}
 
-			recs := make([]string, len(valsRsp.Validators))
-			for i, val := range valsRsp.Validators {
-				bz, err := sdk.ValAddressFromBech32(val.OperatorAddress)
-				if err != nil {
-					return err
-				}
-				// convert to account address
-				recs[i] = sdk.AccAddress(bz).String()
+			thresholdSize, err := cmd.Flags().GetInt(FlagThreshold)
+			if err != nil {
+				return err
 			}
 
-			// query encryption key from chain state
+			// query the encryption keys of the bonded validators from chain state
 			client := types.NewQueryClient(clientCtx)
-			rsp, err := client.Keys(context.Background(), &types.KeysRequest{
-				Addresses: recs,
-			})
+			rsp, err := client.ValidatorsCoverage(context.Background(), &types.ValidatorsCoverageRequest{})
 			if err != nil {
 				return err
 			}
 
-			recipients := make([]age.Recipient, len(recs))
-			for i, key := range rsp.Keys {
-				if len(key) == 0 {
-					fmt.Fprintf(os.Stderr, "missing encryption key for validator %s\n", recs[i])
-					continue
-				}
+			for _, val := range rsp.Uncovered {
+				fmt.Fprintf(os.Stderr, "validator %s is not covered, %s\n", val.OperatorAddress, val.Reason)
+			}
+			// the uncovered validators fail to decrypt the block list, their ProcessProposal diverges then.
+			if len(rsp.Uncovered) > 0 && !allowUncovered {
+				return fmt.Errorf("%d bonded validators are not covered, use --%s to encrypt anyway", len(rsp.Uncovered), FlagAllowUncovered)
+			}
 
-				recipient, err := age.ParseX25519Recipient(key)
+			ui := pluginUI()
+			recipients := make([]age.Recipient, len(rsp.Covered))
+			for i, val := range rsp.Covered {
+				recipient, keyType, err := types.ParseRecipient(val.Key, ui)
 				if err != nil {
-					fmt.Fprintf(os.Stderr, "invalid encryption key for validator %s, %v\n", recs[i], err)
-					continue
+					return err
+				}
+				// the validators decrypt their shares in the consensus path, where no plugin should run.
+				if thresholdSize > 0 && keyType != types.KeyTypeX25519 && keyType != types.KeyTypeHybrid {
+					return fmt.Errorf("validator %s has a %s key, only the x25519 and hybrid keys hold threshold shares", val.OperatorAddress, keyType)
 				}
 				recipients[i] = recipient
 			}
@@ -101,10 +103,37 @@ func EncryptToValidatorsCommand() *cobra.Command {
 				defer fp.Close()
 				output = fp
 			}
+			if thresholdSize > 0 {
+				input, err = ThresholdBlockList(input, recipients, thresholdSize)
+				if err != nil {
+					return err
+				}
+			}
 			return encrypt(recipients, input, output)
 		},
 	}
 	f := cmd.Flags()
 	f.StringP(flags.FlagOutput, "o", "-", "output file (default stdout)")
+	f.Bool(FlagAllowUncovered, false, "encrypt even if some bonded validators have no valid encryption key")
+	f.Int(FlagThreshold, 0, "encrypt the block list so that this many validators are needed to decrypt it, 0 for any single validator")
 	return cmd
 }
+
+// ThresholdBlockList wraps the block list into a threshold list, each validator can only decrypt its own share of the
+// list secret and only publishes the partial decryption of it, the result is still encrypted to all the validators so
+// the chain can check the coverage.
+func ThresholdBlockList(blocklist io.Reader, recipients []age.Recipient, t int) (io.Reader, error) {
+	plaintext, err := io.ReadAll(blocklist)
+	if err != nil {
+		return nil, err
+	}
+	list, err := threshold.Encrypt(plaintext, recipients, t)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(map[string]*threshold.List{"threshold_list": list})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(bz), nil
+}
@@ -12,13 +12,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 )
 
-const FlagKeyringName = "keyring-name"
+const (
+	FlagKeyringName = "keyring-name"
+	FlagKeyType     = "key-type"
+	FlagImport      = "import"
+)
+
+const (
+	keyTypeX25519 = "x25519"
+	keyTypeHybrid = "hybrid"
+)
 
 func KeygenCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "keygen",
-		Short: "Generates a new native X25519 key pair",
-		Args:  cobra.NoArgs,
+		Short: "Generates a new native X25519 or post-quantum hybrid key pair, or imports an existing identity",
+		Long: `Generates a new native X25519 or post-quantum hybrid key pair, or imports an existing identity with --import:
+an ssh-ed25519/ssh-rsa private key in PEM, or an age plugin identity "AGE-PLUGIN-NAME-1..." whose key is held by
+the plugin, e.g. in a hardware token. The passphrase of an encrypted ssh key is prompted on each decryption. The
+plugin binary "age-plugin-name" must be in the PATH. The keys in ssh agents (SSH_AUTH_SOCK) are not supported, as
+decrypting requires the private key rather than a signature, use a plugin backed by the same hardware instead.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -30,26 +44,79 @@ func KeygenCommand() *cobra.Command {
 				return err
 			}
 
-			kr, err := keyring.New("cronosd", clientCtx.Keyring.Backend(), clientCtx.HomeDir, os.Stdin)
+			keyType, err := cmd.Flags().GetString(FlagKeyType)
+			if err != nil {
+				return err
+			}
+
+			importFile, err := cmd.Flags().GetString(FlagImport)
 			if err != nil {
 				return err
 			}
 
-			k, err := age.GenerateX25519Identity()
+			kr, err := keyring.New("cronosd", clientCtx.Keyring.Backend(), clientCtx.HomeDir, os.Stdin)
 			if err != nil {
 				return err
 			}
 
-			if err := kr.Set(krName, []byte(k.String())); err != nil {
+			var secret []byte
+			if importFile != "" {
+				bz, err := os.ReadFile(importFile)
+				if err != nil {
+					return err
+				}
+				secret, err = keyring.ReadIdentityFile(bz)
+				if err != nil {
+					return err
+				}
+				if _, err := keyring.ParseIdentity(secret, nil); err != nil {
+					return err
+				}
+			} else {
+				secret, err = generateIdentity(keyType)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := kr.Set(krName, secret); err != nil {
 				return err
 			}
 
-			fmt.Println(k.Recipient())
+			recipient, err := keyring.Recipient(secret)
+			if err != nil {
+				// the plugin identities are still usable, the recipient is registered from the plugin's output.
+				fmt.Fprintln(os.Stderr, err)
+				return nil
+			}
+			fmt.Println(recipient)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(FlagKeyringName, types.DefaultKeyringName, "The keyring name to use")
+	cmd.Flags().String(FlagKeyType, keyTypeX25519, "The type of the generated key, x25519 or hybrid, the hybrid keys can't be mixed with the other types in an encryption")
+	cmd.Flags().String(FlagImport, "", "Import the identity from the file instead of generating one")
 
 	return cmd
 }
+
+// generateIdentity generates a native age identity of the key type.
+func generateIdentity(keyType string) ([]byte, error) {
+	switch keyType {
+	case keyTypeX25519:
+		k, err := age.GenerateX25519Identity()
+		if err != nil {
+			return nil, err
+		}
+		return []byte(k.String()), nil
+	case keyTypeHybrid:
+		k, err := age.GenerateHybridIdentity()
+		if err != nil {
+			return nil, err
+		}
+		return []byte(k.String()), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q, expect %s or %s", keyType, keyTypeX25519, keyTypeHybrid)
+	}
+}
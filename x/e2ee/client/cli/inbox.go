@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/keyring"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// inboxMessage is a decrypted message of the inbox command, error is set if it can't be decrypted by the local
+// identities.
+type inboxMessage struct {
+	ID      uint64 `json:"id"`
+	Sender  string `json:"sender"`
+	Height  int64  `json:"height"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// inboxOutput is the output of the inbox command.
+type inboxOutput struct {
+	Messages []inboxMessage `json:"messages"`
+	NextKey  []byte         `json:"next_key,omitempty"`
+}
+
+func InboxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inbox [address]",
+		Short: "Fetch the encrypted messages sent to the address and decrypt them with the local identities",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			kr, err := keyring.New("cronosd", clientCtx.Keyring.Backend(), clientCtx.HomeDir, os.Stdin)
+			if err != nil {
+				return err
+			}
+			identityNames, err := cmd.Flags().GetStringArray(FlagIdentity)
+			if err != nil {
+				return err
+			}
+			ui := pluginUI()
+			identities := make([]age.Identity, len(identityNames))
+			for i, name := range identityNames {
+				secret, err := kr.Get(name)
+				if err != nil {
+					return err
+				}
+				identities[i], err = keyring.ParseIdentity(secret, ui)
+				if err != nil {
+					return err
+				}
+			}
+
+			rsp, err := types.NewQueryClient(clientCtx).Inbox(cmd.Context(), &types.InboxRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			output := inboxOutput{Messages: make([]inboxMessage, len(rsp.Messages))}
+			if rsp.Pagination != nil {
+				output.NextKey = rsp.Pagination.NextKey
+			}
+			for i, msg := range rsp.Messages {
+				output.Messages[i] = inboxMessage{
+					ID:     msg.Id,
+					Sender: msg.Sender,
+					Height: msg.Height,
+				}
+				var plaintext bytes.Buffer
+				if err := decrypt(identities, bytes.NewReader(msg.Payload), &plaintext); err != nil {
+					output.Messages[i].Error = err.Error()
+					continue
+				}
+				output.Messages[i].Message = plaintext.String()
+			}
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(output)
+		},
+	}
+
+	cmd.Flags().StringArrayP(FlagIdentity, "i", []string{types.DefaultKeyringName}, "identity (can be repeated)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "inbox")
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/crypto-org-chain/cronos/x/e2ee/keyring"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	FlagTLSCert = "tls-cert"
+	FlagTLSKey  = "tls-key"
+	FlagTLSCA   = "tls-ca"
+)
+
+func KeyServerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-server [address]",
+		Short: "Serve the identities of the local keyring as a remote keyring",
+		Long: `Serve the identities of the local keyring through the e2ee.KeyService, the reference remote keyring standing
+in for a KMS. The address is a unix socket "unix:///path/to/kms.sock" or a tcp "host:port", the tcp address requires the mutual tls
+flags, only the clients with a certificate signed by the --tls-ca are served. The validator connects to it with the
+cronos.e2ee-remote-keyring options.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var tlsConfig keyring.TLSConfig
+			if tlsConfig.CertFile, err = cmd.Flags().GetString(FlagTLSCert); err != nil {
+				return err
+			}
+			if tlsConfig.KeyFile, err = cmd.Flags().GetString(FlagTLSKey); err != nil {
+				return err
+			}
+			if tlsConfig.CAFile, err = cmd.Flags().GetString(FlagTLSCA); err != nil {
+				return err
+			}
+			opts, err := keyring.ServerCredentials(args[0], tlsConfig)
+			if err != nil {
+				return err
+			}
+
+			kr, err := keyring.New("cronosd", clientCtx.Keyring.Backend(), clientCtx.HomeDir, os.Stdin)
+			if err != nil {
+				return err
+			}
+
+			var lis net.Listener
+			if path, ok := strings.CutPrefix(args[0], keyring.UnixSocketScheme); ok {
+				lis, err = net.Listen("unix", path)
+			} else {
+				lis, err = net.Listen("tcp", args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			srv := grpc.NewServer(opts...)
+			types.RegisterKeyServiceServer(srv, keyring.NewKeyServer(kr, pluginUI()))
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				srv.GracefulStop()
+			}()
+
+			fmt.Fprintf(os.Stderr, "serving e2ee keyring on %s\n", args[0])
+			return srv.Serve(lis)
+		},
+	}
+	f := cmd.Flags()
+	f.String(FlagTLSCert, "", "PEM certificate of the server")
+	f.String(FlagTLSKey, "", "PEM private key of the server certificate")
+	f.String(FlagTLSCA, "", "PEM bundle of the CA signing the client certificates")
+	return cmd
+}
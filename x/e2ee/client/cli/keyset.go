@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func ExportKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-keys [genesis-file]",
+		Short: "Export the encryption keys in the genesis file to a key set with checksum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFile, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			_, state, err := readGenesis(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			keySet, err := types.NewKeySet(state.Keys)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(keySet, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if outputFile == "-" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+			return os.WriteFile(outputFile, bz, 0o600)
+		},
+	}
+	cmd.Flags().StringP(flags.FlagOutput, "o", "-", "output file (default stdout)")
+	return cmd
+}
+
+func ImportKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-keys [key-set-file] [genesis-file]",
+		Short: "Import the encryption keys of a key set into the genesis file",
+		Long: `Import the encryption keys of a key set into the genesis file, the checksum of the key set is verified and
+the entries already in the genesis are kept, an entry conflicting with an existing one at the same owner and
+registered height is rejected.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var keySet types.KeySet
+			if err := json.Unmarshal(bz, &keySet); err != nil {
+				return err
+			}
+			if err := keySet.Verify(accAddressBytes); err != nil {
+				return fmt.Errorf("invalid key set: %w", err)
+			}
+
+			genesisFile := args[1]
+			appGenesis, state, err := readGenesis(clientCtx, genesisFile)
+			if err != nil {
+				return err
+			}
+			imported, err := mergeKeyEntries(state, keySet.Keys)
+			if err != nil {
+				return err
+			}
+			if err := state.Validate(); err != nil {
+				return err
+			}
+
+			appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+			if err != nil {
+				return err
+			}
+			appState[types.ModuleName], err = clientCtx.Codec.MarshalJSON(state)
+			if err != nil {
+				return err
+			}
+			appGenesis.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return err
+			}
+			if err := genutil.ExportGenesisFile(appGenesis, genesisFile); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "imported %d of %d encryption keys\n", imported, len(keySet.Keys))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// readGenesis reads the e2ee genesis state from the genesis file.
+func readGenesis(clientCtx client.Context, genesisFile string) (*genutiltypes.AppGenesis, *types.GenesisState, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return nil, nil, err
+	}
+	state := types.DefaultGenesis()
+	if bz, ok := appState[types.ModuleName]; ok {
+		if err := clientCtx.Codec.UnmarshalJSON(bz, state); err != nil {
+			return nil, nil, err
+		}
+	}
+	return appGenesis, state, nil
+}
+
+// mergeKeyEntries appends the entries missing in the genesis state, returning the number of entries appended.
+func mergeKeyEntries(state *types.GenesisState, entries []types.EncryptionKeyEntry) (int, error) {
+	existing := make(map[string]types.EncryptionKeyEntry, len(state.Keys))
+	id := func(entry types.EncryptionKeyEntry) (string, error) {
+		addr, err := accAddressBytes(entry.Address)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%X/%d", addr, entry.RegisteredHeight), nil
+	}
+	for _, entry := range state.Keys {
+		key, err := id(entry)
+		if err != nil {
+			return 0, err
+		}
+		existing[key] = entry
+	}
+
+	imported := 0
+	for _, entry := range entries {
+		key, err := id(entry)
+		if err != nil {
+			return 0, err
+		}
+		if old, ok := existing[key]; ok {
+			if old.Key != entry.Key || old.NotAfter != entry.NotAfter || old.RevokedHeight != entry.RevokedHeight {
+				return 0, fmt.Errorf("encryption key entry of %s at height %d conflicts with the genesis", entry.Address, entry.RegisteredHeight)
+			}
+			continue
+		}
+		existing[key] = entry
+		state.Keys = append(state.Keys, entry)
+		imported++
+	}
+	return imported, nil
+}
+
+func accAddressBytes(s string) ([]byte, error) {
+	return sdk.AccAddressFromBech32(s)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 
-	"filippo.io/age"
 	"github.com/crypto-org-chain/cronos/x/e2ee/keyring"
 	"github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/spf13/cobra"
@@ -38,12 +37,12 @@ func PubKeyCommand() *cobra.Command {
 				return err
 			}
 
-			k, err := age.ParseX25519Identity(string(bz))
+			recipient, err := keyring.Recipient(bz)
 			if err != nil {
 				return err
 			}
 
-			fmt.Println(k.Recipient())
+			fmt.Println(recipient)
 			return nil
 		},
 	}
@@ -21,9 +21,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 	cmd.AddCommand(CmdEncryptionKey())
 	cmd.AddCommand(CmdEncryptionKeys())
+	cmd.AddCommand(CmdEncryptionKeyHistory())
+	cmd.AddCommand(ValidatorsCoverageCommand())
+	cmd.AddCommand(InboxCommand())
+	cmd.AddCommand(CmdParams())
 	return cmd
 }
 
+// FlagAtHeight is the height to fetch the keys valid at, it's different from the height of the queried state.
+const FlagAtHeight = "at-height"
+
 func CmdEncryptionKey() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "key [address]",
@@ -35,9 +42,14 @@ func CmdEncryptionKey() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			atHeight, err := cmd.Flags().GetInt64(FlagAtHeight)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 			params := &types.KeyRequest{
 				Address: args[0],
+				Height:  atHeight,
 			}
 			res, err := queryClient.Key(cmd.Context(), params)
 			if err != nil {
@@ -47,6 +59,7 @@ func CmdEncryptionKey() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(FlagAtHeight, 0, "fetch the key valid at the block height, 0 means the current block height")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -61,9 +74,14 @@ func CmdEncryptionKeys() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			atHeight, err := cmd.Flags().GetInt64(FlagAtHeight)
+			if err != nil {
+				return err
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 			params := &types.KeysRequest{
 				Addresses: args,
+				Height:    atHeight,
 			}
 			res, err := queryClient.Keys(cmd.Context(), params)
 			if err != nil {
@@ -73,6 +91,64 @@ func CmdEncryptionKeys() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(FlagAtHeight, 0, "fetch the key valid at the block height, 0 means the current block height")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEncryptionKeyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-history [address]",
+		Short: "Query the registered encryption keys of an address",
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.KeyHistoryRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.KeyHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "key-history")
+
+	return cmd
+}
+
+func CmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the e2ee module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
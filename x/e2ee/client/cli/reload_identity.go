@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/crypto-org-chain/cronos/x/e2ee/keyring"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const FlagSocket = "socket"
+
+func ReloadIdentityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reload-identity",
+		Short: "Ask the validator node to reload its e2ee identity from the keyring without restarting",
+		Long: `Ask the validator node to reload its e2ee identity from the keyring without restarting, the replaced identity
+keeps decrypting the block list during the grace period. The request goes through the unix socket of the node,
+"data/e2ee.sock" in the node home by default, it's not served on the public grpc endpoint. Sending SIGHUP to the node
+has the same effect.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path, err := cmd.Flags().GetString(FlagSocket)
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				path = types.NodeSocketPath(clientCtx.HomeDir)
+			}
+			conn, err := grpc.NewClient(
+				keyring.UnixSocketScheme+path,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			rsp, err := types.NewNodeClient(conn).ReloadIdentity(cmd.Context(), &types.ReloadIdentityRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(rsp)
+		},
+	}
+	cmd.Flags().String(FlagSocket, "", "path of the unix socket of the node, defaults to data/e2ee.sock in the node home")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+	return cmd
+}
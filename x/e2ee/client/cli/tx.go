@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"os"
+
+	"filippo.io/age"
 
 	"github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/spf13/cobra"
@@ -21,9 +26,13 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 	cmd.AddCommand(CmdRegisterAccount())
+	cmd.AddCommand(CmdRevokeEncryptionKey())
+	cmd.AddCommand(CmdSendEncrypted())
 	return cmd
 }
 
+const FlagNotAfter = "not-after"
+
 func CmdRegisterAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-encryption-key [key]",
@@ -34,7 +43,39 @@ func CmdRegisterAccount() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			notAfter, err := cmd.Flags().GetInt64(FlagNotAfter)
+			if err != nil {
+				return err
+			}
 			msg := types.MsgRegisterEncryptionKey{
+				Address:  clientCtx.GetFromAddress().String(),
+				Key:      args[0],
+				NotAfter: notAfter,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagNotAfter, 0, "the last block height the key is valid at, 0 means no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokeEncryptionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-encryption-key [key]",
+		Short: "Revoke a registered encryption key of the user address, e.g. when it's compromised.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRevokeEncryptionKey{
 				Address: clientCtx.GetFromAddress().String(),
 				Key:     args[0],
 			}
@@ -49,3 +90,74 @@ func CmdRegisterAccount() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSendEncrypted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-encrypted [input-file]",
+		Short: "Encrypt input file to the registered keys of the recipients and store it in their inbox",
+		Long: `Encrypt input file to the registered keys of the recipients and store it in their inbox, the encrypted
+payload is charged by the fee per byte in params on top of the tx fee, and pruned after the retention blocks.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recs, err := cmd.Flags().GetStringArray(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			// query encryption key from chain state
+			rsp, err := types.NewQueryClient(clientCtx).Keys(cmd.Context(), &types.KeysRequest{
+				Addresses: recs,
+			})
+			if err != nil {
+				return err
+			}
+			ui := pluginUI()
+			recipients := make([]age.Recipient, len(recs))
+			for i, key := range rsp.Keys {
+				if key == "" {
+					return fmt.Errorf("no encryption key registered for %s", recs[i])
+				}
+				recipient, _, err := types.ParseRecipient(key, ui)
+				if err != nil {
+					return err
+				}
+				recipients[i] = recipient
+			}
+
+			var input io.Reader
+			if args[0] == "-" {
+				input = os.Stdin
+			} else {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				input = f
+			}
+			var payload bytes.Buffer
+			if err := encrypt(recipients, input, &payload); err != nil {
+				return err
+			}
+
+			msg := types.MsgSendEncrypted{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Recipients: recs,
+				Payload:    payload.Bytes(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringArrayP(FlagRecipient, "r", []string{}, "recipients")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func ValidatorsCoverageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-coverage",
+		Short: "Report the bonded validators without a valid encryption key, they can't decrypt the block list",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rsp, err := types.NewQueryClient(clientCtx).ValidatorsCoverage(cmd.Context(), &types.ValidatorsCoverageRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(rsp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
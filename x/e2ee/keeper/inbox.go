@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MaxPrunedMessagesPerBlock bounds the number of expired messages deleted in one EndBlock, the rest are pruned
+// in the following blocks.
+const MaxPrunedMessagesPerBlock = 1000
+
+// SendEncrypted charges the fee of the payload size and stores the message in the inbox of the recipients.
+func (k Keeper) SendEncrypted(
+	ctx context.Context,
+	req *types.MsgSendEncrypted,
+) (*types.MsgSendEncryptedResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// the msgs can be executed without ValidateBasic, e.g. nested in authz or ica.
+	if err := types.ValidateEncryptedMessage(req.Recipients, req.Payload); err != nil {
+		return nil, err
+	}
+	params := k.GetParams(ctx)
+	if uint64(len(req.Payload)) > params.MaxMessageSize {
+		return nil, types.ErrMessageTooLarge.Wrapf("%d bytes (max %d)", len(req.Payload), params.MaxMessageSize)
+	}
+	if fee := params.MessageFee(len(req.Payload)); !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	id := k.nextMessageID(ctx)
+	msg := types.EncryptedMessage{
+		Id:         id,
+		Sender:     req.Sender,
+		Recipients: req.Recipients,
+		Payload:    req.Payload,
+		Height:     sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	}
+	if err := k.setMessage(ctx, msg); err != nil {
+		return nil, err
+	}
+	k.setNextMessageID(ctx, id+1)
+	return &types.MsgSendEncryptedResponse{Id: id}, nil
+}
+
+// nextMessageID returns the id of the next message, the ids start from 1.
+func (k Keeper) nextMessageID(ctx context.Context) uint64 {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get(types.NextMessageIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextMessageID(ctx context.Context, id uint64) {
+	sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Set(types.NextMessageIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// setMessage stores the message and indexes it in the inbox of the recipients.
+func (k Keeper) setMessage(ctx context.Context, msg types.EncryptedMessage) error {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	for _, recipient := range msg.Recipients {
+		addr, err := k.addressCodec.StringToBytes(recipient)
+		if err != nil {
+			return err
+		}
+		store.Set(types.InboxKey(addr, msg.Id), []byte{})
+	}
+	store.Set(types.MessageKey(msg.Id), k.cdc.MustMarshal(&msg))
+	return nil
+}
+
+// deleteMessage deletes the message and its inbox index entries.
+func (k Keeper) deleteMessage(ctx context.Context, msg types.EncryptedMessage) error {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	for _, recipient := range msg.Recipients {
+		addr, err := k.addressCodec.StringToBytes(recipient)
+		if err != nil {
+			return err
+		}
+		store.Delete(types.InboxKey(addr, msg.Id))
+	}
+	store.Delete(types.MessageKey(msg.Id))
+	return nil
+}
+
+// messages returns the messages not pruned yet in the order of the id.
+func (k Keeper) messages(ctx context.Context) ([]types.EncryptedMessage, error) {
+	store := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.KeyPrefixMessage)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var messages []types.EncryptedMessage
+	for ; iter.Valid(); iter.Next() {
+		var msg types.EncryptedMessage
+		if err := k.cdc.Unmarshal(iter.Value(), &msg); err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// PruneMessages deletes the messages older than the retention blocks in the order of the id, which is the order
+// they are sent in, at most MaxPrunedMessagesPerBlock are deleted at a time. It's called at EndBlock.
+func (k Keeper) PruneMessages(ctx context.Context) error {
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockHeight() - k.GetParams(ctx).RetentionBlocks
+	store := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.KeyPrefixMessage)
+
+	// collect the expired messages first, the store is mutated while deleting them.
+	var expired []types.EncryptedMessage
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid() && len(expired) < MaxPrunedMessagesPerBlock; iter.Next() {
+		var msg types.EncryptedMessage
+		if err := k.cdc.Unmarshal(iter.Value(), &msg); err != nil {
+			iter.Close()
+			return err
+		}
+		if msg.Height > cutoff {
+			break
+		}
+		expired = append(expired, msg)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, msg := range expired {
+		if err := k.deleteMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Inbox returns the messages sent to the address in the order of the id.
+func (k Keeper) Inbox(ctx context.Context, req *types.InboxRequest) (*types.InboxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bz, err := k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	kvStore := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	store := prefix.NewStore(kvStore, types.InboxPrefix(bz))
+
+	var messages []types.EncryptedMessage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		id := sdk.BigEndianToUint64(key)
+		value := kvStore.Get(types.MessageKey(id))
+		if value == nil {
+			return fmt.Errorf("encrypted message %d not found", id)
+		}
+		var msg types.EncryptedMessage
+		if err := k.cdc.Unmarshal(value, &msg); err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.InboxResponse{Messages: messages, Pagination: pageRes}, nil
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"filippo.io/age"
+	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func encryptTo(t *testing.T, msg string, recipients ...age.Recipient) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients...)
+	require.NoError(t, err)
+	_, err = io.WriteString(w, msg)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestSendEncrypted(t *testing.T) {
+	bank := &mockBankKeeper{}
+	k, ctx, codec := setupKeeperWithKeepers(t, &mockStakingKeeper{}, bank)
+	addrs := addresses(t, codec, 3)
+	identities := make([]*age.X25519Identity, 2)
+	for i := range identities {
+		var err error
+		identities[i], err = age.GenerateX25519Identity()
+		require.NoError(t, err)
+	}
+
+	params := e2eetypes.DefaultParams()
+	params.FeePerByte = sdk.NewCoins(sdk.NewCoin("basetcro", sdkmath.NewInt(10)))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	payload := encryptTo(t, "hello", identities[0].Recipient(), identities[1].Recipient())
+	rsp, err := k.SendEncrypted(ctx, &e2eetypes.MsgSendEncrypted{
+		Sender:     addrs[0],
+		Recipients: addrs[1:],
+		Payload:    payload,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), rsp.Id)
+	require.Equal(t, params.MessageFee(len(payload)), bank.fees[authtypes.FeeCollectorName])
+
+	for i, addr := range addrs[1:] {
+		inbox, err := k.Inbox(ctx, &e2eetypes.InboxRequest{Address: addr})
+		require.NoError(t, err)
+		require.Len(t, inbox.Messages, 1)
+		require.Equal(t, addrs[0], inbox.Messages[0].Sender)
+
+		r, err := age.Decrypt(bytes.NewReader(inbox.Messages[0].Payload), identities[i])
+		require.NoError(t, err)
+		plaintext, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(plaintext))
+	}
+
+	inbox, err := k.Inbox(ctx, &e2eetypes.InboxRequest{Address: addrs[0]})
+	require.NoError(t, err)
+	require.Empty(t, inbox.Messages)
+}
+
+func TestSendEncryptedRejectsInvalidMessage(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addrs := addresses(t, codec, 3)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	payload := encryptTo(t, "hello", identity.Recipient())
+
+	testCases := []struct {
+		name       string
+		recipients []string
+		payload    []byte
+		expErr     error
+	}{
+		{"no recipients", nil, payload, e2eetypes.ErrInvalidMessage},
+		{"duplicated recipients", []string{addrs[1], addrs[1]}, payload, e2eetypes.ErrInvalidMessage},
+		{"missing stanzas", addrs[1:], payload, e2eetypes.ErrInvalidMessage},
+		{"not encrypted", addrs[1:2], []byte("hello"), e2eetypes.ErrInvalidAgeHeader},
+		{
+			"too large",
+			addrs[1:2],
+			encryptTo(t, string(make([]byte, e2eetypes.DefaultMaxMessageSize)), identity.Recipient()),
+			e2eetypes.ErrMessageTooLarge,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := k.SendEncrypted(ctx, &e2eetypes.MsgSendEncrypted{
+				Sender:     addrs[0],
+				Recipients: tc.recipients,
+				Payload:    tc.payload,
+			})
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestInboxPagination(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addrs := addresses(t, codec, 2)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err := k.SendEncrypted(ctx, &e2eetypes.MsgSendEncrypted{
+			Sender:     addrs[0],
+			Recipients: addrs[1:],
+			Payload:    encryptTo(t, "hello", identity.Recipient()),
+		})
+		require.NoError(t, err)
+	}
+
+	rsp, err := k.Inbox(ctx, &e2eetypes.InboxRequest{Address: addrs[1], Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, rsp.Messages, 2)
+	require.Equal(t, []uint64{1, 2}, []uint64{rsp.Messages[0].Id, rsp.Messages[1].Id})
+
+	rsp, err = k.Inbox(ctx, &e2eetypes.InboxRequest{Address: addrs[1], Pagination: &query.PageRequest{Key: rsp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, rsp.Messages, 1)
+	require.Equal(t, uint64(3), rsp.Messages[0].Id)
+}
+
+func TestPruneMessages(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addrs := addresses(t, codec, 2)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	params := e2eetypes.DefaultParams()
+	params.RetentionBlocks = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	send := func(height int64) {
+		_, err := k.SendEncrypted(ctx.WithBlockHeight(height), &e2eetypes.MsgSendEncrypted{
+			Sender:     addrs[0],
+			Recipients: addrs[1:],
+			Payload:    encryptTo(t, "hello", identity.Recipient()),
+		})
+		require.NoError(t, err)
+	}
+	send(1)
+	send(5)
+
+	inboxIDs := func() []uint64 {
+		rsp, err := k.Inbox(ctx, &e2eetypes.InboxRequest{Address: addrs[1]})
+		require.NoError(t, err)
+		ids := []uint64{}
+		for _, msg := range rsp.Messages {
+			ids = append(ids, msg.Id)
+		}
+		return ids
+	}
+
+	require.NoError(t, k.PruneMessages(ctx.WithBlockHeight(10)))
+	require.Equal(t, []uint64{1, 2}, inboxIDs())
+
+	require.NoError(t, k.PruneMessages(ctx.WithBlockHeight(11)))
+	require.Equal(t, []uint64{2}, inboxIDs())
+
+	require.NoError(t, k.PruneMessages(ctx.WithBlockHeight(15)))
+	require.Equal(t, []uint64{}, inboxIDs())
+
+	// the ids are not reused after pruning, also across the genesis export.
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Empty(t, genesis.Messages)
+	require.Equal(t, uint64(3), genesis.NextMessageId)
+
+	k2, ctx2, _ := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, genesis))
+	rsp, err := k2.SendEncrypted(ctx2, &e2eetypes.MsgSendEncrypted{
+		Sender:     addrs[0],
+		Recipients: addrs[1:],
+		Payload:    encryptTo(t, "hello", identity.Recipient()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rsp.Id)
+}
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	params := e2eetypes.DefaultParams()
+	params.RetentionBlocks = 1
+
+	_, err := k.UpdateParams(ctx, &e2eetypes.MsgUpdateParams{Authority: "other", Params: params})
+	require.Error(t, err)
+
+	_, err = k.UpdateParams(ctx, &e2eetypes.MsgUpdateParams{Authority: testAuthority, Params: params})
+	require.NoError(t, err)
+	rsp, err := k.Params(ctx, &e2eetypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, rsp.Params)
+}
@@ -9,17 +9,24 @@ import (
 
 	"cosmossdk.io/core/address"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // MaxKeysAddresses caps request body under 1MB (10000* ~100B ≈ ~1MB)
 const MaxKeysAddresses = 10000
 
 type Keeper struct {
-	storeKey     storetypes.StoreKey
-	addressCodec address.Codec
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	addressCodec  address.Codec
+	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority string
 }
 
 var (
@@ -27,66 +34,235 @@ var (
 	_ types.QueryServer = Keeper{}
 )
 
-func NewKeeper(storeKey storetypes.StoreKey, addressCodec address.Codec) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	addressCodec address.Codec,
+	stakingKeeper types.StakingKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
 	return Keeper{
-		storeKey:     storeKey,
-		addressCodec: addressCodec,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		addressCodec:  addressCodec,
+		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
+// registerEncryptionKey appends the key to the key history of the owner, it supersedes the previous key from
+// the current block height, the history is pruned after that.
 func (k Keeper) registerEncryptionKey(
 	ctx context.Context,
 	address string,
-	key []byte,
+	key string,
+	notAfter int64,
 ) error {
+	// the msgs can be executed without ValidateBasic, e.g. nested in authz or ica.
+	keyType, err := types.RecipientKeyType(key)
+	if err != nil {
+		return err
+	}
+	bz, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if notAfter != 0 && notAfter < height {
+		return types.ErrInvalidNotAfter.Wrapf("expired before the registration height %d: %d", height, notAfter)
+	}
+	k.setKeyRecord(ctx, bz, types.EncryptionKeyRecord{
+		Key:              key,
+		RegisteredHeight: height,
+		NotAfter:         notAfter,
+		KeyType:          keyType,
+	})
+	return k.pruneKeyHistory(ctx, bz, height)
+}
+
+// pruneKeyHistory drops the oldest records of the owner, the ones expired or revoked at the height are dropped
+// since they're replaced by a newer registration, and at most MaxKeyHistoryRecords records are kept. Only the
+// oldest records are dropped, so the key at a height before the oldest kept record is unknown rather than wrong.
+func (k Keeper) pruneKeyHistory(ctx context.Context, addr sdk.AccAddress, height int64) error {
+	records, err := k.keyHistory(ctx, addr)
+	if err != nil {
+		return err
+	}
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	// the latest record is never dropped
+	for i, record := range records[:len(records)-1] {
+		if len(records)-i <= types.MaxKeyHistoryRecords && record.ValidAt(height) {
+			break
+		}
+		store.Delete(types.KeyHistoryKey(addr, record.RegisteredHeight))
+	}
+	return nil
+}
+
+func (k Keeper) setKeyRecord(ctx context.Context, addr sdk.AccAddress, record types.EncryptionKeyRecord) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	store.Set(types.KeyHistoryKey(addr, record.RegisteredHeight), k.cdc.MustMarshal(&record))
+}
+
+// revokeEncryptionKey revokes all the registrations of the key from the current block height.
+func (k Keeper) revokeEncryptionKey(ctx context.Context, address string, key string) error {
 	bz, err := k.addressCodec.StringToBytes(address)
 	if err != nil {
 		return err
 	}
-	sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Set(types.KeyPrefix(bz), key)
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	records, err := k.keyHistory(ctx, bz)
+	if err != nil {
+		return err
+	}
+	revoked := false
+	for _, record := range records {
+		if record.Key != key || record.RevokedHeight != 0 {
+			continue
+		}
+		record.RevokedHeight = height
+		k.setKeyRecord(ctx, bz, record)
+		revoked = true
+	}
+	if !revoked {
+		return types.ErrKeyNotFound.Wrapf("no active registration of the key for %s", address)
+	}
 	return nil
 }
 
+// keyHistory returns the key records of the owner in the order of the registered height.
+func (k Keeper) keyHistory(ctx context.Context, addr sdk.AccAddress) ([]types.EncryptionKeyRecord, error) {
+	store := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.KeyHistoryPrefix(addr))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var records []types.EncryptionKeyRecord
+	for ; iter.Valid(); iter.Next() {
+		var record types.EncryptionKeyRecord
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// keyAt returns the key of the owner valid at the height, the latest registration up to the height supersedes
+// the previous ones, so nil is returned if it's expired or revoked at the height.
+func (k Keeper) keyAt(ctx context.Context, addr sdk.AccAddress, height int64) (*types.EncryptionKeyRecord, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.KeyHistoryPrefix(addr), types.KeyHistoryKey(addr, height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil
+	}
+	var record types.EncryptionKeyRecord
+	if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+		return nil, err
+	}
+	if !record.ValidAt(height) {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+// queryHeight returns the height of the key queries, zero means the current block height.
+func queryHeight(ctx context.Context, height int64) (int64, error) {
+	if height < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "negative height: %d", height)
+	}
+	if height == 0 {
+		return sdk.UnwrapSDKContext(ctx).BlockHeight(), nil
+	}
+	return height, nil
+}
+
 func (k Keeper) RegisterEncryptionKey(
 	ctx context.Context,
 	req *types.MsgRegisterEncryptionKey,
 ) (*types.MsgRegisterEncryptionKeyResponse, error) {
-	if err := k.registerEncryptionKey(ctx, req.Address, []byte(req.Key)); err != nil {
+	if err := k.registerEncryptionKey(ctx, req.Address, req.Key, req.NotAfter); err != nil {
 		return nil, err
 	}
 	return &types.MsgRegisterEncryptionKeyResponse{}, nil
 }
 
+func (k Keeper) RevokeEncryptionKey(
+	ctx context.Context,
+	req *types.MsgRevokeEncryptionKey,
+) (*types.MsgRevokeEncryptionKeyResponse, error) {
+	if err := k.revokeEncryptionKey(ctx, req.Address, req.Key); err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeEncryptionKeyResponse{}, nil
+}
+
 func (k Keeper) InitGenesis(
 	ctx context.Context,
 	state *types.GenesisState,
 ) error {
-	for _, key := range state.Keys {
-		if err := k.registerEncryptionKey(ctx, key.Address, []byte(key.Key)); err != nil {
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		return err
+	}
+	if err := types.ValidateKeyEntries(state.Keys, k.addressCodec.StringToBytes); err != nil {
+		return err
+	}
+	for _, entry := range state.Keys {
+		bz, err := k.addressCodec.StringToBytes(entry.Address)
+		if err != nil {
 			return err
 		}
+		k.setKeyRecord(ctx, bz, entry.Record())
 	}
+	for _, msg := range state.Messages {
+		if err := k.setMessage(ctx, msg); err != nil {
+			return err
+		}
+	}
+	k.setNextMessageID(ctx, max(state.NextMessageId, 1))
 	return nil
 }
 
 func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	iter := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.KeyPrefixEncryptionKey).Iterator(nil, nil)
+	iter := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.KeyPrefixEncryptionKeyHistory).Iterator(nil, nil)
 	defer iter.Close()
 
 	var keys []types.EncryptionKeyEntry
 	for ; iter.Valid(); iter.Next() {
-		address, err := k.addressCodec.BytesToString(iter.Key())
+		addr, _, err := types.SplitKeyHistoryKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		address, err := k.addressCodec.BytesToString(addr)
 		if err != nil {
 			return nil, err
 		}
-		key := iter.Value()
+		var record types.EncryptionKeyRecord
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, err
+		}
 		keys = append(keys, types.EncryptionKeyEntry{
-			Address: address,
-			Key:     string(key),
+			Address:          address,
+			Key:              record.Key,
+			RegisteredHeight: record.RegisteredHeight,
+			NotAfter:         record.NotAfter,
+			RevokedHeight:    record.RevokedHeight,
+			KeyType:          record.KeyType,
 		})
 	}
-	return &types.GenesisState{Keys: keys}, nil
+	messages, err := k.messages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{
+		Keys:          keys,
+		Params:        k.GetParams(ctx),
+		Messages:      messages,
+		NextMessageId: k.nextMessageID(ctx),
+	}, nil
 }
 
 func (k Keeper) Key(ctx context.Context, req *types.KeyRequest) (*types.KeyResponse, error) {
@@ -94,9 +270,20 @@ func (k Keeper) Key(ctx context.Context, req *types.KeyRequest) (*types.KeyRespo
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	value := sdkCtx.KVStore(k.storeKey).Get(types.KeyPrefix(bz))
-	return &types.KeyResponse{Key: string(value)}, nil
+	height, err := queryHeight(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	record, err := k.keyAt(ctx, bz, height)
+	if err != nil || record == nil {
+		return &types.KeyResponse{}, err
+	}
+	return &types.KeyResponse{
+		Key:              record.Key,
+		RegisteredHeight: record.RegisteredHeight,
+		NotAfter:         record.NotAfter,
+		KeyType:          record.KeyType,
+	}, nil
 }
 
 func (k Keeper) Keys(ctx context.Context, requests *types.KeysRequest) (*types.KeysResponse, error) {
@@ -104,17 +291,108 @@ func (k Keeper) Keys(ctx context.Context, requests *types.KeysRequest) (*types.K
 		return nil, status.Errorf(codes.InvalidArgument,
 			"too many addresses in Keys request: %d (max %d)", len(requests.Addresses), MaxKeysAddresses)
 	}
+	height, err := queryHeight(ctx, requests.Height)
+	if err != nil {
+		return nil, err
+	}
 
-	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
 	var rsp types.KeysResponse
 	for _, address := range requests.Addresses {
 		bz, err := k.addressCodec.StringToBytes(address)
 		if err != nil {
 			return nil, err
 		}
-		value := store.Get(types.KeyPrefix(bz))
-		rsp.Keys = append(rsp.Keys, string(value))
+		record, err := k.keyAt(ctx, bz, height)
+		if err != nil {
+			return nil, err
+		}
+		var key string
+		if record != nil {
+			key = record.Key
+		}
+		rsp.Keys = append(rsp.Keys, key)
 	}
 
 	return &rsp, nil
 }
+
+func (k Keeper) KeyHistory(ctx context.Context, req *types.KeyHistoryRequest) (*types.KeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	bz, err := k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	store := prefix.NewStore(sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), types.KeyHistoryPrefix(bz))
+
+	var records []types.EncryptionKeyRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.EncryptionKeyRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.KeyHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// ValidatorsCoverage checks the keys of the bonded validators valid at the current block height, it's used in the
+// msg handlers so the result must be deterministic.
+func (k Keeper) ValidatorsCoverage(
+	ctx context.Context,
+	_ *types.ValidatorsCoverageRequest,
+) (*types.ValidatorsCoverageResponse, error) {
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	rsp := &types.ValidatorsCoverageResponse{
+		Covered:   []types.ValidatorKey{},
+		Uncovered: []types.ValidatorKey{},
+	}
+	for _, val := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		// the key is registered by the operator account
+		address, err := k.addressCodec.BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		entry := types.ValidatorKey{
+			OperatorAddress: val.OperatorAddress,
+			Address:         address,
+		}
+		record, err := k.keyAt(ctx, valAddr, height)
+		if err != nil {
+			return nil, err
+		}
+		if entry.Reason = uncoveredReason(record); entry.Reason != "" {
+			rsp.Uncovered = append(rsp.Uncovered, entry)
+			continue
+		}
+		entry.Key = record.Key
+		entry.KeyType = record.KeyType
+		rsp.Covered = append(rsp.Covered, entry)
+	}
+	return rsp, nil
+}
+
+// uncoveredReason returns why the key record doesn't cover the validator, empty if it does.
+func uncoveredReason(record *types.EncryptionKeyRecord) string {
+	if record == nil {
+		return "no encryption key valid at the current height"
+	}
+	if err := types.ValidateRecipientKey(record.Key); err != nil {
+		return err.Error()
+	}
+	return ""
+}
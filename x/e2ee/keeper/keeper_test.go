@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"filippo.io/age"
 	"github.com/crypto-org-chain/cronos/x/e2ee/keeper"
 	e2eetypes "github.com/crypto-org-chain/cronos/x/e2ee/types"
 	"github.com/stretchr/testify/require"
@@ -16,17 +19,62 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-const testBech32Prefix = "crc"
+const (
+	testBech32Prefix = "crc"
+	testAuthority    = "authority"
+)
+
+type mockStakingKeeper struct {
+	validators []stakingtypes.Validator
+}
+
+func (m *mockStakingKeeper) GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error) {
+	return m.validators, nil
+}
+
+type mockBankKeeper struct {
+	fees map[string]sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, module string, amt sdk.Coins) error {
+	if m.fees == nil {
+		m.fees = make(map[string]sdk.Coins)
+	}
+	m.fees[module] = m.fees[module].Add(amt...)
+	return nil
+}
 
 func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context, address.Codec) {
 	t.Helper()
 
+	return setupKeeperWithStaking(t, &mockStakingKeeper{})
+}
+
+func setupKeeperWithStaking(t *testing.T, stakingKeeper e2eetypes.StakingKeeper) (keeper.Keeper, sdk.Context, address.Codec) {
+	t.Helper()
+
+	return setupKeeperWithKeepers(t, stakingKeeper, &mockBankKeeper{})
+}
+
+func setupKeeperWithKeepers(
+	t *testing.T,
+	stakingKeeper e2eetypes.StakingKeeper,
+	bankKeeper e2eetypes.BankKeeper,
+) (keeper.Keeper, sdk.Context, address.Codec) {
+	t.Helper()
+
 	key := storetypes.NewKVStoreKey(e2eetypes.StoreKey)
 	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
 	codec := addresscodec.NewBech32Codec(testBech32Prefix)
-	return keeper.NewKeeper(key, codec), ctx, codec
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	k := keeper.NewKeeper(cdc, key, codec, stakingKeeper, bankKeeper, testAuthority)
+	require.NoError(t, k.SetParams(ctx, e2eetypes.DefaultParams()))
+	return k, ctx, codec
 }
 
 func addresses(t *testing.T, codec address.Codec, n int) []string {
@@ -44,6 +92,14 @@ func addresses(t *testing.T, codec address.Codec, n int) []string {
 	return out
 }
 
+func newRecipient(t *testing.T) string {
+	t.Helper()
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	return identity.Recipient().String()
+}
+
 func TestKeysRejectsOversizedBatch(t *testing.T) {
 	k, ctx, codec := setupKeeper(t)
 
@@ -71,14 +127,322 @@ func TestKeysAcceptsBatchAtLimit(t *testing.T) {
 func TestKeysReturnsRegisteredKeys(t *testing.T) {
 	k, ctx, codec := setupKeeper(t)
 	addrs := addresses(t, codec, 3)
+	key1 := newRecipient(t)
 
 	_, err := k.RegisterEncryptionKey(ctx, &e2eetypes.MsgRegisterEncryptionKey{
 		Address: addrs[1],
-		Key:     "key-1",
+		Key:     key1,
 	})
 	require.NoError(t, err)
 
 	rsp, err := k.Keys(ctx, &e2eetypes.KeysRequest{Addresses: addrs})
 	require.NoError(t, err)
-	require.Equal(t, []string{"", "key-1", ""}, rsp.Keys)
+	require.Equal(t, []string{"", key1, ""}, rsp.Keys)
+}
+
+func TestRegisterRejectsInvalidRecipient(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+
+	for _, key := range []string{"key-1", newRecipient(t) + "malformed", strings.Repeat("a", e2eetypes.MaxRecipientKeySize+1)} {
+		_, err := k.RegisterEncryptionKey(ctx, &e2eetypes.MsgRegisterEncryptionKey{
+			Address: addr,
+			Key:     key,
+		})
+		require.ErrorIs(t, err, e2eetypes.ErrInvalidRecipient)
+	}
+
+	rsp, err := k.Key(ctx, &e2eetypes.KeyRequest{Address: addr})
+	require.NoError(t, err)
+	require.Empty(t, rsp.Key)
+}
+
+func TestKeyRotation(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+	keyA, keyB, keyC := newRecipient(t), newRecipient(t), newRecipient(t)
+
+	_, err := k.RegisterEncryptionKey(ctx.WithBlockHeight(10), &e2eetypes.MsgRegisterEncryptionKey{
+		Address: addr,
+		Key:     keyA,
+	})
+	require.NoError(t, err)
+	_, err = k.RegisterEncryptionKey(ctx.WithBlockHeight(20), &e2eetypes.MsgRegisterEncryptionKey{
+		Address:  addr,
+		Key:      keyB,
+		NotAfter: 30,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(25)
+	for height, expected := range map[int64]string{
+		5:  "",
+		15: keyA,
+		20: keyB,
+		0:  keyB,
+		30: keyB,
+		31: "",
+	} {
+		rsp, err := k.Key(ctx, &e2eetypes.KeyRequest{Address: addr, Height: height})
+		require.NoError(t, err)
+		require.Equal(t, expected, rsp.Key, "height %d", height)
+	}
+
+	rsp, err := k.Keys(ctx, &e2eetypes.KeysRequest{Addresses: []string{addr}, Height: 15})
+	require.NoError(t, err)
+	require.Equal(t, []string{keyA}, rsp.Keys)
+
+	_, err = k.RegisterEncryptionKey(ctx, &e2eetypes.MsgRegisterEncryptionKey{
+		Address:  addr,
+		Key:      keyC,
+		NotAfter: 24,
+	})
+	require.ErrorIs(t, err, e2eetypes.ErrInvalidNotAfter)
+}
+
+func TestRevokeEncryptionKey(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+	keyA, keyB := newRecipient(t), newRecipient(t)
+
+	_, err := k.RegisterEncryptionKey(ctx.WithBlockHeight(10), &e2eetypes.MsgRegisterEncryptionKey{
+		Address: addr,
+		Key:     keyA,
+	})
+	require.NoError(t, err)
+
+	_, err = k.RevokeEncryptionKey(ctx.WithBlockHeight(12), &e2eetypes.MsgRevokeEncryptionKey{
+		Address: addr,
+		Key:     keyB,
+	})
+	require.ErrorIs(t, err, e2eetypes.ErrKeyNotFound)
+
+	_, err = k.RevokeEncryptionKey(ctx.WithBlockHeight(12), &e2eetypes.MsgRevokeEncryptionKey{
+		Address: addr,
+		Key:     keyA,
+	})
+	require.NoError(t, err)
+
+	rsp, err := k.Key(ctx.WithBlockHeight(12), &e2eetypes.KeyRequest{Address: addr, Height: 11})
+	require.NoError(t, err)
+	require.Equal(t, keyA, rsp.Key)
+	rsp, err = k.Key(ctx.WithBlockHeight(12), &e2eetypes.KeyRequest{Address: addr})
+	require.NoError(t, err)
+	require.Empty(t, rsp.Key)
+
+	history, err := k.KeyHistory(ctx, &e2eetypes.KeyHistoryRequest{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, []e2eetypes.EncryptionKeyRecord{
+		{Key: keyA, RegisteredHeight: 10, RevokedHeight: 12},
+	}, history.Records)
+
+	// the key history is kept in the genesis
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	k2, ctx2, _ := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, genesis))
+	history2, err := k2.KeyHistory(ctx2, &e2eetypes.KeyHistoryRequest{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, history.Records, history2.Records)
+}
+
+func TestKeyHistoryPruning(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+	keyA, keyB, keyC := newRecipient(t), newRecipient(t), newRecipient(t)
+	register := func(height int64, key string, notAfter int64) {
+		t.Helper()
+		_, err := k.RegisterEncryptionKey(ctx.WithBlockHeight(height), &e2eetypes.MsgRegisterEncryptionKey{
+			Address:  addr,
+			Key:      key,
+			NotAfter: notAfter,
+		})
+		require.NoError(t, err)
+	}
+	historyKeys := func() []string {
+		t.Helper()
+		rsp, err := k.KeyHistory(ctx, &e2eetypes.KeyHistoryRequest{Address: addr})
+		require.NoError(t, err)
+		keys := make([]string, len(rsp.Records))
+		for i, record := range rsp.Records {
+			keys[i] = record.Key
+		}
+		return keys
+	}
+
+	// the expired key is dropped once replaced
+	register(10, keyA, 15)
+	register(20, keyB, 0)
+	require.Equal(t, []string{keyB}, historyKeys())
+	rsp, err := k.Key(ctx.WithBlockHeight(20), &e2eetypes.KeyRequest{Address: addr, Height: 12})
+	require.NoError(t, err)
+	require.Empty(t, rsp.Key)
+
+	// the revoked key is dropped once replaced
+	_, err = k.RevokeEncryptionKey(ctx.WithBlockHeight(25), &e2eetypes.MsgRevokeEncryptionKey{Address: addr, Key: keyB})
+	require.NoError(t, err)
+	require.Equal(t, []string{keyB}, historyKeys())
+	register(30, keyC, 0)
+	require.Equal(t, []string{keyC}, historyKeys())
+
+	// the valid keys are kept up to the cap
+	for i := int64(0); i < e2eetypes.MaxKeyHistoryRecords+5; i++ {
+		register(100+i, keyC, 0)
+	}
+	history, err := k.KeyHistory(ctx, &e2eetypes.KeyHistoryRequest{Address: addr})
+	require.NoError(t, err)
+	require.Len(t, history.Records, e2eetypes.MaxKeyHistoryRecords)
+	require.Equal(t, int64(105), history.Records[0].RegisteredHeight)
+}
+
+func TestKeyHistoryPagination(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+	key := newRecipient(t)
+	for height := int64(1); height <= 5; height++ {
+		_, err := k.RegisterEncryptionKey(ctx.WithBlockHeight(height), &e2eetypes.MsgRegisterEncryptionKey{
+			Address: addr,
+			Key:     key,
+		})
+		require.NoError(t, err)
+	}
+
+	rsp, err := k.KeyHistory(ctx, &e2eetypes.KeyHistoryRequest{Address: addr, Pagination: &query.PageRequest{Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, rsp.Records, 3)
+	require.Equal(t, int64(1), rsp.Records[0].RegisteredHeight)
+	require.NotNil(t, rsp.Pagination.NextKey)
+
+	rsp, err = k.KeyHistory(ctx, &e2eetypes.KeyHistoryRequest{Address: addr, Pagination: &query.PageRequest{Key: rsp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, rsp.Records, 2)
+	require.Equal(t, int64(4), rsp.Records[0].RegisteredHeight)
+	require.Nil(t, rsp.Pagination.NextKey)
+
+	_, err = k.KeyHistory(ctx, &e2eetypes.KeyHistoryRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestValidatorsCoverage(t *testing.T) {
+	stakingKeeper := &mockStakingKeeper{}
+	k, ctx, codec := setupKeeperWithStaking(t, stakingKeeper)
+	ctx = ctx.WithBlockHeight(10)
+	addrs := addresses(t, codec, 3)
+	for _, addr := range addrs {
+		bz, err := codec.StringToBytes(addr)
+		require.NoError(t, err)
+		stakingKeeper.validators = append(stakingKeeper.validators, stakingtypes.Validator{
+			OperatorAddress: sdk.ValAddress(bz).String(),
+		})
+	}
+	key0, key2 := newRecipient(t), newRecipient(t)
+
+	_, err := k.RegisterEncryptionKey(ctx, &e2eetypes.MsgRegisterEncryptionKey{
+		Address: addrs[0],
+		Key:     key0,
+	})
+	require.NoError(t, err)
+	_, err = k.RegisterEncryptionKey(ctx, &e2eetypes.MsgRegisterEncryptionKey{
+		Address: addrs[2],
+		Key:     key2,
+	})
+	require.NoError(t, err)
+	_, err = k.RevokeEncryptionKey(ctx, &e2eetypes.MsgRevokeEncryptionKey{
+		Address: addrs[2],
+		Key:     key2,
+	})
+	require.NoError(t, err)
+
+	rsp, err := k.ValidatorsCoverage(ctx, &e2eetypes.ValidatorsCoverageRequest{})
+	require.NoError(t, err)
+	require.Len(t, rsp.Covered, 1)
+	require.Equal(t, stakingKeeper.validators[0].OperatorAddress, rsp.Covered[0].OperatorAddress)
+	require.Equal(t, addrs[0], rsp.Covered[0].Address)
+	require.Equal(t, key0, rsp.Covered[0].Key)
+	require.Empty(t, rsp.Covered[0].Reason)
+
+	require.Len(t, rsp.Uncovered, 2)
+	for i, addr := range []string{addrs[1], addrs[2]} {
+		require.Equal(t, addr, rsp.Uncovered[i].Address)
+		require.Empty(t, rsp.Uncovered[i].Key)
+		require.NotEmpty(t, rsp.Uncovered[i].Reason)
+	}
+}
+
+func TestRegisterRecordsKeyType(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+	identity, err := age.GenerateHybridIdentity()
+	require.NoError(t, err)
+	key := identity.Recipient().String()
+
+	_, err = k.RegisterEncryptionKey(ctx, &e2eetypes.MsgRegisterEncryptionKey{
+		Address: addr,
+		Key:     key,
+	})
+	require.NoError(t, err)
+
+	rsp, err := k.Key(ctx, &e2eetypes.KeyRequest{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, key, rsp.Key)
+	require.Equal(t, e2eetypes.KeyTypeHybrid, rsp.KeyType)
+
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, e2eetypes.KeyTypeHybrid, genesis.Keys[0].KeyType)
+}
+
+func TestInitGenesisRejectsInvalidKeys(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addr := addresses(t, codec, 1)[0]
+	entry := e2eetypes.EncryptionKeyEntry{Address: addr, Key: newRecipient(t), RegisteredHeight: 1}
+
+	for _, keys := range [][]e2eetypes.EncryptionKeyEntry{
+		{entry, entry},
+		{{Address: addr, Key: "invalid"}},
+		// the address of another chain
+		{{Address: sdk.AccAddress(make([]byte, 20)).String(), Key: entry.Key}},
+	} {
+		genesis := e2eetypes.DefaultGenesis()
+		genesis.Keys = keys
+		require.Error(t, k.InitGenesis(ctx, genesis))
+	}
+
+	genesis := e2eetypes.DefaultGenesis()
+	genesis.Keys = []e2eetypes.EncryptionKeyEntry{entry}
+	require.NoError(t, k.InitGenesis(ctx, genesis))
+}
+
+func TestExportGenesisKeyHistory(t *testing.T) {
+	k, ctx, codec := setupKeeper(t)
+	addrs := addresses(t, codec, 2)
+	// the rotations beyond the cap are pruned, so the exported history passes the validation
+	for height := int64(1); height <= e2eetypes.MaxKeyHistoryRecords+10; height++ {
+		for _, addr := range addrs {
+			_, err := k.RegisterEncryptionKey(ctx.WithBlockHeight(height), &e2eetypes.MsgRegisterEncryptionKey{
+				Address: addr,
+				Key:     newRecipient(t),
+			})
+			require.NoError(t, err)
+		}
+	}
+	// the revoked current key stays in the history
+	rsp, err := k.Key(ctx.WithBlockHeight(200), &e2eetypes.KeyRequest{Address: addrs[0]})
+	require.NoError(t, err)
+	_, err = k.RevokeEncryptionKey(ctx.WithBlockHeight(200), &e2eetypes.MsgRevokeEncryptionKey{
+		Address: addrs[0],
+		Key:     rsp.Key,
+	})
+	require.NoError(t, err)
+
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesis.Keys, 2*e2eetypes.MaxKeyHistoryRecords)
+	require.NoError(t, e2eetypes.ValidateKeyEntries(genesis.Keys, codec.StringToBytes))
+
+	k2, ctx2, _ := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, genesis))
+	exported, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, genesis.Keys, exported.Keys)
 }
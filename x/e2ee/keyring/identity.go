@@ -0,0 +1,117 @@
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"filippo.io/age/plugin"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	x25519IdentityPrefix = "AGE-SECRET-KEY-1"
+	hybridIdentityPrefix = "AGE-SECRET-KEY-PQ-1"
+	pluginIdentityPrefix = "AGE-PLUGIN-"
+	pemPrefix            = "-----BEGIN"
+)
+
+// ParseIdentity parses the secret stored in the keyring, it's an age identity string, a plugin identity
+// "AGE-PLUGIN-NAME-1..." referencing a key held by the plugin, e.g. in a hardware token, or an ssh private key in
+// PEM. The plugin identities run the plugin binary when unwrapping the file key, and the passphrase of the
+// encrypted ssh keys is requested then, interacting with the user through the ui. The keys held by ssh agents are
+// not supported, the agent protocol only signs and can't unwrap the file key.
+func ParseIdentity(secret []byte, ui *plugin.ClientUI) (age.Identity, error) {
+	s := strings.TrimSpace(string(secret))
+	switch {
+	case strings.HasPrefix(s, hybridIdentityPrefix):
+		return age.ParseHybridIdentity(s)
+	case strings.HasPrefix(s, x25519IdentityPrefix):
+		return age.ParseX25519Identity(s)
+	case strings.HasPrefix(s, pluginIdentityPrefix):
+		return plugin.NewIdentity(s, ui)
+	case strings.HasPrefix(s, pemPrefix):
+		return parseSSHIdentity(secret, ui)
+	default:
+		return nil, errors.New("unknown identity type")
+	}
+}
+
+// Recipient returns the recipient of the secret stored in the keyring to register on chain, the recipients of the
+// plugin identities are only known to the plugins.
+func Recipient(secret []byte) (string, error) {
+	identity, err := ParseIdentity(secret, nil)
+	if err != nil {
+		return "", err
+	}
+	switch identity := identity.(type) {
+	case *age.X25519Identity:
+		return identity.Recipient().String(), nil
+	case *age.HybridIdentity:
+		return identity.Recipient().String(), nil
+	case *plugin.Identity:
+		return "", fmt.Errorf("the recipient of the identity is provided by age-plugin-%s", identity.Name())
+	default:
+		pubKey, err := sshPublicKey(secret)
+		if err != nil {
+			return "", err
+		}
+		return string(bytes.TrimSpace(ssh.MarshalAuthorizedKey(pubKey))), nil
+	}
+}
+
+// parseSSHIdentity parses the ssh private key in PEM, the encrypted one is decrypted with the passphrase requested
+// through the ui on each unwrap.
+func parseSSHIdentity(pemBytes []byte, ui *plugin.ClientUI) (age.Identity, error) {
+	identity, err := agessh.ParseIdentity(pemBytes)
+	var missing *ssh.PassphraseMissingError
+	if !errors.As(err, &missing) {
+		return identity, err
+	}
+	if missing.PublicKey == nil {
+		return nil, errors.New("encrypted ssh private key in the legacy PEM format is not supported, convert it with ssh-keygen -p")
+	}
+	return agessh.NewEncryptedSSHIdentity(missing.PublicKey, pemBytes, func() ([]byte, error) {
+		if ui == nil || ui.RequestValue == nil {
+			return nil, errors.New("the passphrase of the encrypted ssh private key can't be requested")
+		}
+		passphrase, err := ui.RequestValue("ssh", "Enter the passphrase of the ssh private key", true)
+		return []byte(passphrase), err
+	})
+}
+
+// sshPublicKey returns the public key of the ssh private key in PEM, it's stored in clear in the encrypted one.
+func sshPublicKey(pemBytes []byte) (ssh.PublicKey, error) {
+	key, err := ssh.ParseRawPrivateKey(pemBytes)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) && missing.PublicKey != nil {
+		return missing.PublicKey, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return signer.PublicKey(), nil
+}
+
+// ReadIdentityFile reads the identity to import into the keyring, the ssh private key in PEM is kept as is, the
+// age identity files keep the first identity, skipping the comments.
+func ReadIdentityFile(bz []byte) ([]byte, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(bz), []byte(pemPrefix)) {
+		return bz, nil
+	}
+	for _, line := range strings.Split(string(bz), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		return []byte(line), nil
+	}
+	return nil, errors.New("no identity found")
+}
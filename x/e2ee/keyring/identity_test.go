@@ -0,0 +1,108 @@
+package keyring
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/plugin"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseIdentity(t *testing.T) {
+	x25519, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	hybrid, err := age.GenerateHybridIdentity()
+	require.NoError(t, err)
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+
+	for _, secret := range [][]byte{
+		[]byte(x25519.String()),
+		[]byte(hybrid.String()),
+		pem.EncodeToMemory(block),
+	} {
+		key, err := Recipient(secret)
+		require.NoError(t, err)
+		recipient, _, err := types.ParseRecipient(key, nil)
+		require.NoError(t, err)
+		identity, err := ParseIdentity(secret, nil)
+		require.NoError(t, err)
+
+		var ciphertext bytes.Buffer
+		w, err := age.Encrypt(&ciphertext, recipient)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("test"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := age.Decrypt(&ciphertext, identity)
+		require.NoError(t, err)
+		plaintext, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "test", string(plaintext))
+	}
+
+	_, err = ParseIdentity([]byte("invalid"), nil)
+	require.Error(t, err)
+}
+
+func TestParseEncryptedSSHIdentity(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(priv, "", []byte("passphrase"))
+	require.NoError(t, err)
+	secret := pem.EncodeToMemory(block)
+
+	// the recipient is known without the passphrase
+	key, err := Recipient(secret)
+	require.NoError(t, err)
+	recipient, _, err := types.ParseRecipient(key, nil)
+	require.NoError(t, err)
+
+	encrypt := func() *bytes.Buffer {
+		var ciphertext bytes.Buffer
+		w, err := age.Encrypt(&ciphertext, recipient)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("test"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return &ciphertext
+	}
+
+	// the passphrase is requested through the ui
+	identity, err := ParseIdentity(secret, &plugin.ClientUI{
+		RequestValue: func(_, _ string, secret bool) (string, error) {
+			require.True(t, secret)
+			return "passphrase", nil
+		},
+	})
+	require.NoError(t, err)
+	r, err := age.Decrypt(encrypt(), identity)
+	require.NoError(t, err)
+	plaintext, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "test", string(plaintext))
+
+	identity, err = ParseIdentity(secret, nil)
+	require.NoError(t, err)
+	_, err = age.Decrypt(encrypt(), identity)
+	require.Error(t, err)
+}
+
+func TestReadIdentityFile(t *testing.T) {
+	secret, err := ReadIdentityFile([]byte("# created: 2024-01-01\n# recipient: age1yubikey1...\nAGE-PLUGIN-YUBIKEY-1STUB\n"))
+	require.NoError(t, err)
+	require.Equal(t, "AGE-PLUGIN-YUBIKEY-1STUB", string(secret))
+
+	_, err = ReadIdentityFile([]byte("# no identity\n"))
+	require.Error(t, err)
+}
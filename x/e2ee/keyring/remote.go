@@ -0,0 +1,105 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"google.golang.org/grpc"
+)
+
+// DefaultRemoteTimeout bounds the calls to the remote keyring.
+const DefaultRemoteTimeout = 5 * time.Second
+
+// ErrSecretNotExportable is returned when reading or writing the secrets of the remote keyring.
+var ErrSecretNotExportable = errors.New("the secrets of the remote keyring are not exportable")
+
+// IdentityProvider is implemented by the keyrings holding the identities without exporting the secrets.
+type IdentityProvider interface {
+	// Identity returns the identity and its recipient, the recipient changes when the key is rotated.
+	Identity(name string) (age.Identity, string, error)
+}
+
+var (
+	_ Keyring          = (*RemoteKeyring)(nil)
+	_ IdentityProvider = (*RemoteKeyring)(nil)
+)
+
+// RemoteKeyring is a keyring backed by the KeyService, e.g. served by a KMS, the file keys are unwrapped on the
+// remote side so the secrets never reach the node.
+type RemoteKeyring struct {
+	conn    *grpc.ClientConn
+	client  types.KeyServiceClient
+	timeout time.Duration
+}
+
+// NewRemote connects to the KeyService at the address, a unix socket "unix:///path" keeps the unwrapped file keys
+// off the network, the transport security is configured by the dial options.
+func NewRemote(address string, opts ...grpc.DialOption) (*RemoteKeyring, error) {
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteKeyring{
+		conn:    conn,
+		client:  types.NewKeyServiceClient(conn),
+		timeout: DefaultRemoteTimeout,
+	}, nil
+}
+
+func (*RemoteKeyring) Get(string) ([]byte, error) {
+	return nil, ErrSecretNotExportable
+}
+
+func (*RemoteKeyring) Set(string, []byte) error {
+	return ErrSecretNotExportable
+}
+
+// Identity returns the identity unwrapping by the remote keyring, the key must exist.
+func (r *RemoteKeyring) Identity(name string) (age.Identity, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	rsp, err := r.client.Recipient(ctx, &types.RecipientRequest{KeyId: name})
+	if err != nil {
+		return nil, "", err
+	}
+	return &RemoteIdentity{keyring: r, keyID: name}, rsp.Recipient, nil
+}
+
+// Close closes the connection to the remote keyring.
+func (r *RemoteKeyring) Close() error {
+	return r.conn.Close()
+}
+
+var _ age.Identity = (*RemoteIdentity)(nil)
+
+// RemoteIdentity is an identity held by the remote keyring.
+type RemoteIdentity struct {
+	keyring *RemoteKeyring
+	keyID   string
+}
+
+// Unwrap sends the stanzas to the remote keyring, age.ErrIncorrectIdentity is returned if none of them is for the
+// identity.
+func (i *RemoteIdentity) Unwrap(stanzas []*age.Stanza) ([]byte, error) {
+	req := &types.UnwrapRequest{
+		KeyId:   i.keyID,
+		Stanzas: make([]*types.Stanza, len(stanzas)),
+	}
+	for j, stanza := range stanzas {
+		req.Stanzas[j] = &types.Stanza{Type: stanza.Type, Args: stanza.Args, Body: stanza.Body}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), i.keyring.timeout)
+	defer cancel()
+	rsp, err := i.keyring.client.Unwrap(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.FileKey) == 0 {
+		return nil, age.ErrIncorrectIdentity
+	}
+	return rsp.FileKey, nil
+}
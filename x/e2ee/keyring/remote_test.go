@@ -0,0 +1,74 @@
+package keyring
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+func TestRemoteKeyring(t *testing.T) {
+	local, err := New("cronosd", keyring.BackendMemory, "", nil)
+	require.NoError(t, err)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	require.NoError(t, local.Set(types.DefaultKeyringName, []byte(identity.String())))
+
+	// the unix socket path is limited in length, so not under t.TempDir
+	dir, err := os.MkdirTemp("", "kms")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "kms.sock")
+	lis, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	types.RegisterKeyServiceServer(srv, NewKeyServer(local, nil))
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	kr, err := NewRemote("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { kr.Close() })
+
+	_, err = kr.Get(types.DefaultKeyringName)
+	require.ErrorIs(t, err, ErrSecretNotExportable)
+	require.ErrorIs(t, kr.Set(types.DefaultKeyringName, nil), ErrSecretNotExportable)
+	_, _, err = kr.Identity("unknown")
+	require.Error(t, err)
+
+	remote, recipient, err := kr.Identity(types.DefaultKeyringName)
+	require.NoError(t, err)
+	require.Equal(t, identity.Recipient().String(), recipient)
+
+	encrypt := func(recipient age.Recipient) []byte {
+		var buf bytes.Buffer
+		w, err := age.Encrypt(&buf, recipient)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, "blocklist")
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	r, err := age.Decrypt(bytes.NewReader(encrypt(identity.Recipient())), remote)
+	require.NoError(t, err)
+	plaintext, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "blocklist", string(plaintext))
+
+	other, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	_, err = age.Decrypt(bytes.NewReader(encrypt(other.Recipient())), remote)
+	var noMatch *age.NoIdentityMatchError
+	require.ErrorAs(t, err, &noMatch)
+}
@@ -0,0 +1,70 @@
+package keyring
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+)
+
+var _ age.Identity = (*RotatingIdentity)(nil)
+
+// RotatingIdentity is an identity replaceable at runtime, the replaced identity keeps unwrapping until the end of
+// the grace period, so the blobs encrypted to either the old or the new key are decrypted during a key rotation.
+type RotatingIdentity struct {
+	mu          sync.RWMutex
+	current     age.Identity
+	previous    age.Identity
+	graceEnd    time.Time
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+// NewRotatingIdentity returns a rotating identity starting with the identity, which can be nil if it's not loaded
+// yet.
+func NewRotatingIdentity(identity age.Identity, gracePeriod time.Duration) *RotatingIdentity {
+	return &RotatingIdentity{
+		current:     identity,
+		gracePeriod: gracePeriod,
+		now:         time.Now,
+	}
+}
+
+// Rotate replaces the current identity, the replaced one is kept for the grace period.
+func (r *RotatingIdentity) Rotate(identity age.Identity) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.current != nil {
+		r.previous = r.current
+		r.graceEnd = r.now().Add(r.gracePeriod)
+	}
+	r.current = identity
+}
+
+// Identities returns the current identity, followed by the previous one if it's still in the grace period.
+func (r *RotatingIdentity) Identities() []age.Identity {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var identities []age.Identity
+	if r.current != nil {
+		identities = append(identities, r.current)
+	}
+	if r.previous != nil && r.now().Before(r.graceEnd) {
+		identities = append(identities, r.previous)
+	}
+	return identities
+}
+
+// Unwrap tries the identities in order like age.Decrypt does with multiple identities, age.ErrIncorrectIdentity is
+// returned if none of them matches.
+func (r *RotatingIdentity) Unwrap(stanzas []*age.Stanza) ([]byte, error) {
+	for _, identity := range r.Identities() {
+		fileKey, err := identity.Unwrap(stanzas)
+		if errors.Is(err, age.ErrIncorrectIdentity) {
+			continue
+		}
+		return fileKey, err
+	}
+	return nil, age.ErrIncorrectIdentity
+}
@@ -0,0 +1,51 @@
+package keyring
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingIdentity(t *testing.T) {
+	encrypt := func(recipient age.Recipient) []byte {
+		var buf bytes.Buffer
+		w, err := age.Encrypt(&buf, recipient)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, "blocklist")
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	canDecrypt := func(identity age.Identity, blob []byte) bool {
+		_, err := age.Decrypt(bytes.NewReader(blob), identity)
+		return err == nil
+	}
+
+	oldKey, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	newKey, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	toOld, toNew := encrypt(oldKey.Recipient()), encrypt(newKey.Recipient())
+
+	now := time.Unix(1000, 0)
+	identity := NewRotatingIdentity(nil, time.Hour)
+	identity.now = func() time.Time { return now }
+	require.False(t, canDecrypt(identity, toOld))
+
+	// the first identity loaded has no grace period
+	identity.Rotate(oldKey)
+	require.True(t, canDecrypt(identity, toOld))
+	require.False(t, canDecrypt(identity, toNew))
+
+	identity.Rotate(newKey)
+	require.True(t, canDecrypt(identity, toOld))
+	require.True(t, canDecrypt(identity, toNew))
+
+	now = now.Add(time.Hour)
+	require.False(t, canDecrypt(identity, toOld))
+	require.True(t, canDecrypt(identity, toNew))
+}
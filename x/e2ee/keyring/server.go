@@ -0,0 +1,62 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+
+	"filippo.io/age"
+	"filippo.io/age/plugin"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.KeyServiceServer = (*KeyServer)(nil)
+
+// KeyServer is the reference KeyService serving the identities of a local keyring, it stands in for a KMS in the
+// tests and the setups keeping the keyring on a separate host.
+type KeyServer struct {
+	kr Keyring
+	ui *plugin.ClientUI
+}
+
+// NewKeyServer serves the identities in the keyring, the plugin identities interact through the ui.
+func NewKeyServer(kr Keyring, ui *plugin.ClientUI) *KeyServer {
+	return &KeyServer{kr: kr, ui: ui}
+}
+
+func (s *KeyServer) Recipient(_ context.Context, req *types.RecipientRequest) (*types.RecipientResponse, error) {
+	secret, err := s.kr.Get(req.KeyId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	recipient, err := Recipient(secret)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &types.RecipientResponse{Recipient: recipient}, nil
+}
+
+func (s *KeyServer) Unwrap(_ context.Context, req *types.UnwrapRequest) (*types.UnwrapResponse, error) {
+	secret, err := s.kr.Get(req.KeyId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	identity, err := ParseIdentity(secret, s.ui)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	stanzas := make([]*age.Stanza, len(req.Stanzas))
+	for i, stanza := range req.Stanzas {
+		stanzas[i] = &age.Stanza{Type: stanza.Type, Args: stanza.Args, Body: stanza.Body}
+	}
+	fileKey, err := identity.Unwrap(stanzas)
+	if errors.Is(err, age.ErrIncorrectIdentity) {
+		return &types.UnwrapResponse{}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.UnwrapResponse{FileKey: fileKey}, nil
+}
@@ -0,0 +1,118 @@
+package keyring
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// UnixSocketScheme prefixes the unix socket addresses of the KeyService.
+const UnixSocketScheme = "unix://"
+
+// TLSConfig is the transport security of the KeyService connection, the unwrapped file keys cross it, so it's
+// mandatory unless the address is a unix socket.
+type TLSConfig struct {
+	// CertFile and KeyFile are the PEM certificate and key of the local side, the client certificate of the node or
+	// the server certificate of the key server.
+	CertFile string
+	KeyFile  string
+	// CAFile is the PEM bundle verifying the peer, the server certificate on the node, the system roots if empty,
+	// or the client certificates on the key server.
+	CAFile string
+}
+
+// Enabled returns true if any of the files is configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+func (c TLSConfig) certificates() ([]tls.Certificate, error) {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return nil, errors.New("the tls certificate and key must be configured together")
+	}
+	if c.CertFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return []tls.Certificate{cert}, nil
+}
+
+func (c TLSConfig) certPool() (*x509.CertPool, error) {
+	if c.CAFile == "" {
+		return nil, nil
+	}
+	bz, err := os.ReadFile(c.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no certificate in %s", c.CAFile)
+	}
+	return pool, nil
+}
+
+// ClientCredentials returns the transport credentials of the connection to the KeyService at the address, the
+// connection is only left unencrypted on a unix socket without tls configured.
+func ClientCredentials(address string, c TLSConfig) (grpc.DialOption, error) {
+	if !c.Enabled() {
+		if !strings.HasPrefix(address, UnixSocketScheme) {
+			return nil, fmt.Errorf("remote keyring %s is not a unix socket, tls must be configured", address)
+		}
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+	certs, err := c.certificates()
+	if err != nil {
+		return nil, err
+	}
+	pool, err := c.certPool()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		Certificates: certs,
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	})), nil
+}
+
+// ServerCredentials returns the transport credentials of the key server listening at the address, the clients must
+// present a certificate signed by the CA unless it's a unix socket without tls configured.
+func ServerCredentials(address string, c TLSConfig) ([]grpc.ServerOption, error) {
+	if !c.Enabled() {
+		if !strings.HasPrefix(address, UnixSocketScheme) {
+			return nil, fmt.Errorf("key server %s is not a unix socket, mutual tls must be configured", address)
+		}
+		return nil, nil
+	}
+	certs, err := c.certificates()
+	if err != nil {
+		return nil, err
+	}
+	if certs == nil {
+		return nil, errors.New("the key server needs a tls certificate and key")
+	}
+	pool, err := c.certPool()
+	if err != nil {
+		return nil, err
+	}
+	if pool == nil {
+		return nil, errors.New("the key server needs a tls ca to authenticate the clients")
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: certs,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}))}, nil
+}
@@ -0,0 +1,136 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T, dir, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	file := filepath.Join(dir, name+".pem")
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue writes the certificate and key signed by the CA, returns their files.
+func (ca *testCA) issue(t *testing.T, dir, name string, usage x509.ExtKeyUsage) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
+
+func TestTLSCredentials(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+	// a client certificate from another CA is refused
+	otherCert, otherKey := newTestCA(t, dir, "other").issue(t, dir, "intruder", x509.ExtKeyUsageClientAuth)
+
+	// the tcp addresses require the tls
+	_, err := ClientCredentials("127.0.0.1:9000", TLSConfig{})
+	require.Error(t, err)
+	_, err = ServerCredentials("127.0.0.1:9000", TLSConfig{})
+	require.Error(t, err)
+	// the key server authenticates the clients
+	_, err = ServerCredentials("127.0.0.1:9000", TLSConfig{CertFile: serverCert, KeyFile: serverKey})
+	require.Error(t, err)
+	_, err = ClientCredentials("127.0.0.1:9000", TLSConfig{CertFile: clientCert, CAFile: ca.file})
+	require.Error(t, err)
+	_, err = ClientCredentials("unix:///tmp/kms.sock", TLSConfig{})
+	require.NoError(t, err)
+	opts, err := ServerCredentials("unix:///tmp/kms.sock", TLSConfig{})
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	local, err := New("cronosd", keyring.BackendMemory, "", nil)
+	require.NoError(t, err)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	require.NoError(t, local.Set(types.DefaultKeyringName, []byte(identity.String())))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := lis.Addr().String()
+	opts, err = ServerCredentials(address, TLSConfig{CertFile: serverCert, KeyFile: serverKey, CAFile: ca.file})
+	require.NoError(t, err)
+	srv := grpc.NewServer(opts...)
+	types.RegisterKeyServiceServer(srv, NewKeyServer(local, nil))
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	identityOf := func(c TLSConfig) (string, error) {
+		creds, err := ClientCredentials(address, c)
+		require.NoError(t, err)
+		kr, err := NewRemote(address, creds)
+		require.NoError(t, err)
+		defer kr.Close()
+		_, recipient, err := kr.Identity(types.DefaultKeyringName)
+		return recipient, err
+	}
+
+	recipient, err := identityOf(TLSConfig{CertFile: clientCert, KeyFile: clientKey, CAFile: ca.file})
+	require.NoError(t, err)
+	require.Equal(t, identity.Recipient().String(), recipient)
+
+	_, err = identityOf(TLSConfig{CertFile: otherCert, KeyFile: otherKey, CAFile: ca.file})
+	require.Error(t, err)
+	// the server certificate isn't trusted by the system roots
+	_, err = identityOf(TLSConfig{CertFile: clientCert, KeyFile: clientKey})
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/e2ee module state from the consensus version 1 to version 2. Specifically, it moves the
+// single key of each owner into the key history, the registration height is unknown so it's recorded as zero.
+// The keys that are not valid recipients are pruned, so they are reported as missing rather than skipped silently
+// by the encryptions to the validators. The default params of the encrypted inbox are set.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	params := types.DefaultParams()
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	legacy := prefix.NewStore(store, types.KeyPrefixEncryptionKey)
+	iter := legacy.Iterator(nil, nil)
+	var (
+		addrs []sdk.AccAddress
+		keys  []string
+	)
+	for ; iter.Valid(); iter.Next() {
+		addrs = append(addrs, sdk.AccAddress(iter.Key()))
+		keys = append(keys, string(iter.Value()))
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, addr := range addrs {
+		legacy.Delete(addr)
+		keyType, err := types.RecipientKeyType(keys[i])
+		if err != nil {
+			ctx.Logger().Info("prune invalid e2ee key", "address", addr.String(), "error", err.Error())
+			continue
+		}
+		record := types.EncryptionKeyRecord{Key: keys[i], KeyType: keyType}
+		store.Set(types.KeyHistoryKey(addr, 0), cdc.MustMarshal(&record))
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package v2_test
+
+import (
+	"testing"
+
+	"filippo.io/age"
+	v2 "github.com/crypto-org-chain/cronos/x/e2ee/migrations/v2"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("test"))
+	store := ctx.KVStore(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	valid := identity.Recipient().String()
+
+	addrs := []sdk.AccAddress{make([]byte, 20), make([]byte, 32)}
+	addrs[1][0] = 1
+	store.Set(types.KeyPrefix(addrs[0]), []byte(valid))
+	store.Set(types.KeyPrefix(addrs[1]), []byte("invalid"))
+
+	require.NoError(t, v2.Migrate(ctx, store, cdc))
+	for _, addr := range addrs {
+		require.Nil(t, store.Get(types.KeyPrefix(addr)))
+	}
+	var record types.EncryptionKeyRecord
+	require.NoError(t, cdc.Unmarshal(store.Get(types.KeyHistoryKey(addrs[0], 0)), &record))
+	require.Equal(t, types.EncryptionKeyRecord{Key: valid}, record)
+	// the invalid key is pruned
+	require.Nil(t, store.Get(types.KeyHistoryKey(addrs[1], 0)))
+
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &params))
+	require.Equal(t, types.DefaultParams(), params)
+}
@@ -22,14 +22,19 @@ import (
 )
 
 var (
-	_ appmodule.AppModule   = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
-	_ module.HasGenesis     = AppModule{}
-	_ module.HasServices    = (*AppModule)(nil)
-	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ appmodule.AppModule     = AppModule{}
+	_ module.AppModuleBasic   = AppModuleBasic{}
+	_ module.HasGenesis       = AppModule{}
+	_ module.HasServices      = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
 	// this line is used by starport scaffolding # ibc/module/interface
 )
 
+const (
+	ConsensusVersion = 2
+)
+
 // ----------------------------------------------------------------------------
 // AppModuleBasic
 // ----------------------------------------------------------------------------
@@ -115,6 +120,11 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -135,8 +145,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock prunes the encrypted messages older than the retention blocks set in params.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.PruneMessages(ctx)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 // Name returns the capability module's name.
 func (am AppModule) Name() string {
@@ -0,0 +1,339 @@
+package threshold
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+
+	"filippo.io/age"
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	payloadKeyDomain = "cronos-threshold-list-key"
+	proofDomain      = "cronos-threshold-list-proof"
+)
+
+// List is a payload encrypted to the secret scalar split into the shares, each share is encrypted to a single
+// recipient, so decrypting it needs the partial decryptions of Threshold of the recipients.
+type List struct {
+	Threshold int `json:"threshold"`
+	// Ephemeral is the ephemeral point R of the ElGamal encryption, the payload key is derived from the secret
+	// times R.
+	Ephemeral []byte `json:"ephemeral"`
+	// Ciphertext is the payload sealed with the payload key.
+	Ciphertext []byte           `json:"ciphertext"`
+	Shares     []EncryptedShare `json:"shares"`
+}
+
+// EncryptedShare is a share of the secret scalar encrypted to a single recipient.
+type EncryptedShare struct {
+	Index   byte   `json:"index"`
+	Payload []byte `json:"payload"`
+	// Commitment is the share times the base point, the shares and the partial decryptions are verified against it.
+	Commitment []byte `json:"commitment"`
+}
+
+// Partial is the partial decryption of the list by a share holder, the share times the ephemeral point, with the
+// Chaum-Pedersen proof that it's computed with the committed share. It reveals nothing about the share.
+type Partial struct {
+	Index     byte   `json:"index"`
+	Point     []byte `json:"point"`
+	Challenge []byte `json:"challenge"`
+	Response  []byte `json:"response"`
+}
+
+// Encrypt encrypts the plaintext so that any threshold of the recipients decrypt it together.
+func Encrypt(plaintext []byte, recipients []age.Recipient, threshold int) (*List, error) {
+	secret, err := randomScalar()
+	if err != nil {
+		return nil, err
+	}
+	r, err := randomScalar()
+	if err != nil {
+		return nil, err
+	}
+	ephemeral := new(edwards25519.Point).ScalarBaseMult(r)
+	shared := new(edwards25519.Point).ScalarBaseMult(secret)
+	shared.ScalarMult(r, shared)
+	ciphertext, err := seal(ephemeral, shared, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := Split(secret, len(recipients), threshold)
+	if err != nil {
+		return nil, err
+	}
+	list := &List{
+		Threshold:  threshold,
+		Ephemeral:  ephemeral.Bytes(),
+		Ciphertext: ciphertext,
+		Shares:     make([]EncryptedShare, len(shares)),
+	}
+	for i, share := range shares {
+		payload, err := encrypt(share.Value, recipients[i])
+		if err != nil {
+			return nil, err
+		}
+		value, err := edwards25519.NewScalar().SetCanonicalBytes(share.Value)
+		if err != nil {
+			return nil, err
+		}
+		list.Shares[i] = EncryptedShare{
+			Index:      share.Index,
+			Payload:    payload,
+			Commitment: new(edwards25519.Point).ScalarBaseMult(value).Bytes(),
+		}
+	}
+	return list, nil
+}
+
+// Validate checks the structure of the list, it can't check the shares without decrypting them.
+func (l *List) Validate() error {
+	if l.Threshold < 1 || l.Threshold > len(l.Shares) {
+		return fmt.Errorf("invalid threshold %d of %d shares", l.Threshold, len(l.Shares))
+	}
+	if len(l.Shares) > MaxShares {
+		return fmt.Errorf("too many shares %d, max %d", len(l.Shares), MaxShares)
+	}
+	if len(l.Ciphertext) == 0 {
+		return errors.New("empty ciphertext")
+	}
+	if _, err := parsePoint(l.Ephemeral); err != nil {
+		return fmt.Errorf("invalid ephemeral point: %w", err)
+	}
+	if _, err := shareIndexes(len(l.Shares), func(i int) byte { return l.Shares[i].Index }); err != nil {
+		return err
+	}
+	for _, share := range l.Shares {
+		if _, err := parsePoint(share.Commitment); err != nil {
+			return fmt.Errorf("invalid commitment of share %d: %w", share.Index, err)
+		}
+	}
+	return nil
+}
+
+// DecryptShare decrypts the share encrypted to the identity, it fails if the identity holds no share.
+func (l *List) DecryptShare(identity age.Identity) (Share, error) {
+	for _, encrypted := range l.Shares {
+		value, err := decrypt(encrypted.Payload, identity)
+		if err != nil {
+			continue
+		}
+		share := Share{Index: encrypted.Index, Value: value}
+		if !l.VerifyShare(share) {
+			return Share{}, fmt.Errorf("share %d doesn't match its commitment", share.Index)
+		}
+		return share, nil
+	}
+	return Share{}, errors.New("no share for the identity")
+}
+
+// VerifyShare checks the share against the commitment in the list.
+func (l *List) VerifyShare(share Share) bool {
+	commitment, ok := l.commitment(share.Index)
+	if !ok {
+		return false
+	}
+	value, err := edwards25519.NewScalar().SetCanonicalBytes(share.Value)
+	if err != nil {
+		return false
+	}
+	return new(edwards25519.Point).ScalarBaseMult(value).Equal(commitment) == 1
+}
+
+// PartialDecrypt computes the partial decryption of the list with the share.
+func (l *List) PartialDecrypt(share Share) (Partial, error) {
+	if !l.VerifyShare(share) {
+		return Partial{}, fmt.Errorf("share %d doesn't match its commitment", share.Index)
+	}
+	ephemeral, err := parsePoint(l.Ephemeral)
+	if err != nil {
+		return Partial{}, err
+	}
+	value, err := edwards25519.NewScalar().SetCanonicalBytes(share.Value)
+	if err != nil {
+		return Partial{}, err
+	}
+	commitment, _ := l.commitment(share.Index)
+	point := new(edwards25519.Point).ScalarMult(value, ephemeral)
+
+	// prove log_B(commitment) == log_R(point) without revealing the share.
+	w, err := randomScalar()
+	if err != nil {
+		return Partial{}, err
+	}
+	a1 := new(edwards25519.Point).ScalarBaseMult(w)
+	a2 := new(edwards25519.Point).ScalarMult(w, ephemeral)
+	challenge := proofChallenge(share.Index, commitment, ephemeral, point, a1, a2)
+	response := edwards25519.NewScalar().MultiplyAdd(challenge, value, w)
+	return Partial{
+		Index:     share.Index,
+		Point:     point.Bytes(),
+		Challenge: challenge.Bytes(),
+		Response:  response.Bytes(),
+	}, nil
+}
+
+// VerifyPartial checks the proof of the partial decryption against the commitment in the list.
+func (l *List) VerifyPartial(partial Partial) bool {
+	commitment, ok := l.commitment(partial.Index)
+	if !ok {
+		return false
+	}
+	ephemeral, err := parsePoint(l.Ephemeral)
+	if err != nil {
+		return false
+	}
+	point, err := parsePoint(partial.Point)
+	if err != nil {
+		return false
+	}
+	challenge, err := edwards25519.NewScalar().SetCanonicalBytes(partial.Challenge)
+	if err != nil {
+		return false
+	}
+	response, err := edwards25519.NewScalar().SetCanonicalBytes(partial.Response)
+	if err != nil {
+		return false
+	}
+	negChallenge := edwards25519.NewScalar().Negate(challenge)
+	// a1 = response*B - challenge*commitment, a2 = response*R - challenge*point
+	a1 := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(negChallenge, commitment, response)
+	a2 := new(edwards25519.Point).VarTimeMultiScalarMult(
+		[]*edwards25519.Scalar{response, negChallenge}, []*edwards25519.Point{ephemeral, point},
+	)
+	return proofChallenge(partial.Index, commitment, ephemeral, point, a1, a2).Equal(challenge) == 1
+}
+
+// Decrypt combines the partial decryptions and decrypts the payload, the partial decryptions failing the
+// verification are ignored.
+func (l *List) Decrypt(partials []Partial) ([]byte, error) {
+	valid := make([]Partial, 0, l.Threshold)
+	seen := make(map[byte]struct{}, len(partials))
+	for _, partial := range partials {
+		if len(valid) == l.Threshold {
+			break
+		}
+		if _, ok := seen[partial.Index]; ok || !l.VerifyPartial(partial) {
+			continue
+		}
+		seen[partial.Index] = struct{}{}
+		valid = append(valid, partial)
+	}
+	if len(valid) < l.Threshold {
+		return nil, fmt.Errorf("not enough partial decryptions, %d of %d", len(valid), l.Threshold)
+	}
+
+	indexes := make([]byte, len(valid))
+	for i, partial := range valid {
+		indexes[i] = partial.Index
+	}
+	scalars := make([]*edwards25519.Scalar, len(valid))
+	points := make([]*edwards25519.Point, len(valid))
+	for i, partial := range valid {
+		scalars[i] = lagrangeAtZero(partial.Index, indexes)
+		// verified above
+		points[i], _ = parsePoint(partial.Point)
+	}
+	shared := new(edwards25519.Point).VarTimeMultiScalarMult(scalars, points)
+	ephemeral, err := parsePoint(l.Ephemeral)
+	if err != nil {
+		return nil, err
+	}
+	return open(ephemeral, shared, l.Ciphertext)
+}
+
+func (l *List) commitment(index byte) (*edwards25519.Point, bool) {
+	for _, encrypted := range l.Shares {
+		if encrypted.Index == index {
+			point, err := parsePoint(encrypted.Commitment)
+			return point, err == nil
+		}
+	}
+	return nil, false
+}
+
+// parsePoint decodes the point, rejecting the ones with a small order component so the proofs are sound.
+func parsePoint(bz []byte) (*edwards25519.Point, error) {
+	point, err := new(edwards25519.Point).SetBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	// l*P is the identity only in the prime order subgroup, computed as (l-1)*P + P.
+	minusOne := edwards25519.NewScalar().Negate(indexScalar(1))
+	check := new(edwards25519.Point).ScalarMult(minusOne, point)
+	if check.Add(check, point).Equal(edwards25519.NewIdentityPoint()) != 1 {
+		return nil, errors.New("point not in the prime order subgroup")
+	}
+	return point, nil
+}
+
+func proofChallenge(index byte, commitment, ephemeral, point, a1, a2 *edwards25519.Point) *edwards25519.Scalar {
+	h := sha512.New()
+	h.Write([]byte(proofDomain))
+	h.Write([]byte{index})
+	for _, p := range []*edwards25519.Point{commitment, ephemeral, point, a1, a2} {
+		h.Write(p.Bytes())
+	}
+	challenge, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		panic(err)
+	}
+	return challenge
+}
+
+// payloadAEAD derives the payload key from the shared point, the ephemeral point is unique to the list so the key
+// is used once.
+func payloadAEAD(ephemeral, shared *edwards25519.Point) (cipher.AEAD, error) {
+	h := sha256.New()
+	h.Write([]byte(payloadKeyDomain))
+	h.Write(ephemeral.Bytes())
+	h.Write(shared.Bytes())
+	return chacha20poly1305.New(h.Sum(nil))
+}
+
+func seal(ephemeral, shared *edwards25519.Point, plaintext []byte) ([]byte, error) {
+	aead, err := payloadAEAD(ephemeral, shared)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, make([]byte, aead.NonceSize()), plaintext, nil), nil
+}
+
+func open(ephemeral, shared *edwards25519.Point, ciphertext []byte) ([]byte, error) {
+	aead, err := payloadAEAD(ephemeral, shared)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext, nil)
+}
+
+func encrypt(plaintext []byte, recipients ...age.Recipient) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decrypt(ciphertext []byte, identity age.Identity) ([]byte, error) {
+	r, err := age.Decrypt(bytes.NewReader(ciphertext), identity)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
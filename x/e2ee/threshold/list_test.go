@@ -0,0 +1,102 @@
+package threshold
+
+import (
+	"bytes"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDecrypt(t *testing.T) {
+	plaintext := []byte(`{"addresses":["crc1q04jewhxw4xxu3vlg3rc85240h9q7ns6hglz0g"]}`)
+	identities := make([]*age.X25519Identity, 4)
+	recipients := make([]age.Recipient, len(identities))
+	for i := range identities {
+		identity, err := age.GenerateX25519Identity()
+		require.NoError(t, err)
+		identities[i] = identity
+		recipients[i] = identity.Recipient()
+	}
+
+	list, err := Encrypt(plaintext, recipients, 3)
+	require.NoError(t, err)
+	require.NoError(t, list.Validate())
+
+	partials := make([]Partial, len(identities))
+	for i, identity := range identities {
+		share, err := list.DecryptShare(identity)
+		require.NoError(t, err)
+		partials[i], err = list.PartialDecrypt(share)
+		require.NoError(t, err)
+		require.True(t, list.VerifyPartial(partials[i]))
+	}
+	outsider, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	_, err = list.DecryptShare(outsider)
+	require.Error(t, err)
+
+	// fewer partial decryptions than the threshold don't decrypt
+	_, err = list.Decrypt(partials[:2])
+	require.Error(t, err)
+	_, err = list.Decrypt([]Partial{partials[0], partials[0], partials[1]})
+	require.Error(t, err)
+
+	result, err := list.Decrypt(partials[1:])
+	require.NoError(t, err)
+	require.Equal(t, plaintext, result)
+
+	// the bogus partial decryptions are skipped
+	bogus := partials[0]
+	bogus.Point = new(edwards25519.Point).ScalarBaseMult(indexScalar(7)).Bytes()
+	require.False(t, list.VerifyPartial(bogus))
+	swapped := partials[1]
+	swapped.Index = partials[0].Index
+	require.False(t, list.VerifyPartial(swapped))
+	_, err = list.Decrypt([]Partial{bogus, partials[1], partials[2]})
+	require.Error(t, err)
+	result, err = list.Decrypt([]Partial{bogus, partials[1], partials[2], partials[3]})
+	require.NoError(t, err)
+	require.Equal(t, plaintext, result)
+}
+
+func TestShareCommitment(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	list, err := Encrypt([]byte("payload"), []age.Recipient{identity.Recipient(), identity.Recipient()}, 2)
+	require.NoError(t, err)
+	share, err := list.DecryptShare(identity)
+	require.NoError(t, err)
+	require.True(t, list.VerifyShare(share))
+
+	bogus := Share{Index: share.Index, Value: indexScalar(1).Bytes()}
+	require.False(t, list.VerifyShare(bogus))
+	_, err = list.PartialDecrypt(bogus)
+	require.Error(t, err)
+}
+
+func TestListValidate(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	list, err := Encrypt([]byte("payload"), []age.Recipient{identity.Recipient(), identity.Recipient()}, 2)
+	require.NoError(t, err)
+	require.NoError(t, list.Validate())
+
+	invalid := *list
+	invalid.Threshold = 3
+	require.Error(t, invalid.Validate())
+
+	invalid = *list
+	invalid.Shares = []EncryptedShare{list.Shares[0], list.Shares[0]}
+	require.Error(t, invalid.Validate())
+
+	invalid = *list
+	invalid.Ciphertext = nil
+	require.Error(t, invalid.Validate())
+
+	// the point (0, -1) of order 2
+	invalid = *list
+	invalid.Ephemeral = append(append([]byte{0xec}, bytes.Repeat([]byte{0xff}, 30)...), 0x7f)
+	require.Error(t, invalid.Validate())
+}
@@ -0,0 +1,131 @@
+// Package threshold implements the t-of-n encryption of the block list with a threshold ElGamal scheme over
+// edwards25519. The secret scalar of the list is split with Shamir's secret sharing and each share is encrypted to a
+// single validator, the validators publish the partial decryptions proven against the share commitments, so the
+// plaintext needs the cooperation of a quorum of the validators while the shares are never revealed, not even to
+// the other validators.
+package threshold
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+)
+
+// MaxShares is the max number of shares, the share indexes are the non-zero bytes.
+const MaxShares = 255
+
+// Share is a share of a secret scalar, Index is the x coordinate and Value is the canonical encoding of the y
+// coordinate.
+type Share struct {
+	Index byte
+	Value []byte
+}
+
+// Split splits the secret into n shares, any threshold of them recover the secret while fewer reveal nothing.
+func Split(secret *edwards25519.Scalar, n, threshold int) ([]Share, error) {
+	if threshold < 1 || threshold > n || n > MaxShares {
+		return nil, fmt.Errorf("invalid threshold %d of %d shares, max %d", threshold, n, MaxShares)
+	}
+
+	// a random polynomial of degree threshold-1, the constant term is the secret.
+	coeffs := make([]*edwards25519.Scalar, threshold)
+	coeffs[0] = secret
+	for i := 1; i < threshold; i++ {
+		coeff, err := randomScalar()
+		if err != nil {
+			return nil, err
+		}
+		coeffs[i] = coeff
+	}
+
+	shares := make([]Share, n)
+	for i := range shares {
+		index := byte(i + 1)
+		shares[i] = Share{Index: index, Value: evaluate(coeffs, indexScalar(index)).Bytes()}
+	}
+	return shares, nil
+}
+
+// Combine recovers the secret from the shares by the Lagrange interpolation at zero, the result is garbage if the
+// shares are fewer than the threshold or inconsistent, the callers verify it.
+func Combine(shares []Share) (*edwards25519.Scalar, error) {
+	indexes, err := shareIndexes(len(shares), func(i int) byte { return shares[i].Index })
+	if err != nil {
+		return nil, err
+	}
+	secret := edwards25519.NewScalar()
+	for _, share := range shares {
+		value, err := edwards25519.NewScalar().SetCanonicalBytes(share.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid share %d: %w", share.Index, err)
+		}
+		secret.MultiplyAdd(value, lagrangeAtZero(share.Index, indexes), secret)
+	}
+	return secret, nil
+}
+
+// shareIndexes checks the indexes are non-zero and distinct.
+func shareIndexes(n int, index func(int) byte) ([]byte, error) {
+	if n == 0 {
+		return nil, errors.New("no shares")
+	}
+	indexes := make([]byte, n)
+	seen := make(map[byte]struct{}, n)
+	for i := range indexes {
+		indexes[i] = index(i)
+		if indexes[i] == 0 {
+			return nil, errors.New("invalid share index 0")
+		}
+		if _, ok := seen[indexes[i]]; ok {
+			return nil, fmt.Errorf("duplicated share index %d", indexes[i])
+		}
+		seen[indexes[i]] = struct{}{}
+	}
+	return indexes, nil
+}
+
+// lagrangeAtZero returns the lagrange basis polynomial of the index at zero, the indexes are distinct.
+func lagrangeAtZero(index byte, indexes []byte) *edwards25519.Scalar {
+	x := indexScalar(index)
+	basis := indexScalar(1)
+	for _, other := range indexes {
+		if other == index {
+			continue
+		}
+		// other / (other - index)
+		o := indexScalar(other)
+		denominator := edwards25519.NewScalar().Subtract(o, x)
+		basis.Multiply(basis, o)
+		basis.Multiply(basis, edwards25519.NewScalar().Invert(denominator))
+	}
+	return basis
+}
+
+// evaluate evaluates the polynomial at x by the Horner's method.
+func evaluate(coeffs []*edwards25519.Scalar, x *edwards25519.Scalar) *edwards25519.Scalar {
+	y := edwards25519.NewScalar()
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y.MultiplyAdd(y, x, coeffs[i])
+	}
+	return y
+}
+
+func indexScalar(index byte) *edwards25519.Scalar {
+	var bz [32]byte
+	bz[0] = index
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(bz[:])
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func randomScalar() (*edwards25519.Scalar, error) {
+	var bz [64]byte
+	if _, err := rand.Read(bz[:]); err != nil {
+		return nil, err
+	}
+	return edwards25519.NewScalar().SetUniformBytes(bz[:])
+}
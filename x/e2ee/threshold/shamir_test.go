@@ -0,0 +1,46 @@
+package threshold
+
+import (
+	"testing"
+
+	"filippo.io/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCombine(t *testing.T) {
+	secret, err := randomScalar()
+	require.NoError(t, err)
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		picked := make([]Share, len(subset))
+		for i, j := range subset {
+			picked[i] = shares[j]
+		}
+		recovered, err := Combine(picked)
+		require.NoError(t, err)
+		require.Equal(t, 1, secret.Equal(recovered))
+	}
+
+	// fewer shares than the threshold don't recover the secret
+	recovered, err := Combine(shares[:2])
+	require.NoError(t, err)
+	require.Equal(t, 0, secret.Equal(recovered))
+
+	_, err = Combine([]Share{shares[0], shares[0]})
+	require.Error(t, err)
+	_, err = Combine([]Share{{Index: 0, Value: shares[0].Value}})
+	require.Error(t, err)
+}
+
+func TestSplitInvalid(t *testing.T) {
+	secret := edwards25519.NewScalar()
+	_, err := Split(secret, 3, 4)
+	require.Error(t, err)
+	_, err = Split(secret, 3, 0)
+	require.Error(t, err)
+	_, err = Split(secret, MaxShares+1, 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"filippo.io/age"
+	"golang.org/x/crypto/ssh"
+)
+
+// ageScryptStanzaType is the stanza type of the passphrase recipients, it's never a validator.
+const ageScryptStanzaType = "scrypt"
+
+var errStanzasCollected = errors.New("stanzas collected")
+
+// stanzaCollector is an identity collecting the recipient stanzas, it never unwraps the file key.
+type stanzaCollector struct {
+	stanzas []*age.Stanza
+}
+
+func (c *stanzaCollector) Unwrap(stanzas []*age.Stanza) ([]byte, error) {
+	for _, stanza := range stanzas {
+		if stanza.Type != ageScryptStanzaType {
+			c.stanzas = append(c.stanzas, stanza)
+		}
+	}
+	return nil, errStanzasCollected
+}
+
+// RecipientStanzas returns the recipient stanzas in the header of the age encrypted blob, without the passphrase
+// ones.
+func RecipientStanzas(blob []byte) ([]*age.Stanza, error) {
+	collector := new(stanzaCollector)
+	_, err := age.Decrypt(bytes.NewReader(blob), collector)
+	if !errors.Is(err, errStanzasCollected) {
+		return nil, ErrInvalidAgeHeader.Wrapf("%v", err)
+	}
+	return collector.stanzas, nil
+}
+
+// CountRecipientStanzas counts the recipient stanzas in the header of the age encrypted blob, each recipient wraps
+// the file key in at least one stanza. The native stanzas don't reveal their recipients, so without the identities
+// a verifier can only check the number of recipients.
+func CountRecipientStanzas(blob []byte) (int, error) {
+	stanzas, err := RecipientStanzas(blob)
+	return len(stanzas), err
+}
+
+// SSHStanzaTag returns the type and the tag of the stanzas wrapped to the ssh public key, the tag is the truncated
+// hash of the key, so unlike the native ones, the ssh stanzas are matched to their recipients.
+func SSHStanzaTag(key string) (string, error) {
+	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return "", ErrInvalidRecipient.Wrap(err.Error())
+	}
+	h := sha256.Sum256(pk.Marshal())
+	return pk.Type() + " " + base64.RawStdEncoding.EncodeToString(h[:4]), nil
+}
+
+// StanzaSSHTag returns the type and the tag of the ssh stanza, false if it's not an ssh stanza.
+func StanzaSSHTag(stanza *age.Stanza) (string, bool) {
+	if !strings.HasPrefix(stanza.Type, sshRecipientPrefix) || len(stanza.Args) == 0 {
+		return "", false
+	}
+	return stanza.Type + " " + stanza.Args[0], true
+}
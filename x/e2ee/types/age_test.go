@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func encryptTo(t *testing.T, n int) []byte {
+	t.Helper()
+
+	recipients := make([]age.Recipient, n)
+	for i := range recipients {
+		identity, err := age.GenerateX25519Identity()
+		require.NoError(t, err)
+		recipients[i] = identity.Recipient()
+	}
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients...)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestCountRecipientStanzas(t *testing.T) {
+	for _, n := range []int{1, 3} {
+		count, err := types.CountRecipientStanzas(encryptTo(t, n))
+		require.NoError(t, err)
+		require.Equal(t, n, count)
+	}
+
+	blob := encryptTo(t, 2)
+	header, _, found := bytes.Cut(blob, []byte("\n--- "))
+	require.True(t, found)
+
+	for _, invalid := range [][]byte{nil, []byte("plain text"), header} {
+		_, err := types.CountRecipientStanzas(invalid)
+		require.ErrorIs(t, err, types.ErrInvalidAgeHeader)
+	}
+}
+
+func TestSSHStanzaTag(t *testing.T) {
+	x25519, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	keys := make([]string, 2)
+	recipients := []age.Recipient{x25519.Recipient()}
+	for i := range keys {
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		sshPub, err := ssh.NewPublicKey(pub)
+		require.NoError(t, err)
+		keys[i] = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))) + " user@host"
+		recipient, _, err := types.ParseRecipient(keys[i], nil)
+		require.NoError(t, err)
+		recipients = append(recipients, recipient)
+	}
+
+	// encrypted to the x25519 key and the first ssh key only
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, recipients[:2]...)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	stanzas, err := types.RecipientStanzas(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, stanzas, 2)
+
+	_, ok := types.StanzaSSHTag(stanzas[0])
+	require.False(t, ok)
+	tag, ok := types.StanzaSSHTag(stanzas[1])
+	require.True(t, ok)
+
+	expected, err := types.SSHStanzaTag(keys[0])
+	require.NoError(t, err)
+	require.Equal(t, expected, tag)
+	other, err := types.SSHStanzaTag(keys[1])
+	require.NoError(t, err)
+	require.NotEqual(t, expected, other)
+
+	_, err = types.SSHStanzaTag(x25519.Recipient().String())
+	require.Error(t, err)
+}
@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterEncryptionKey{},
+		&MsgRevokeEncryptionKey{},
+		&MsgSendEncrypted{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
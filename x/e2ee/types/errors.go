@@ -0,0 +1,26 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+const (
+	codeErrInvalidNotAfter = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrKeyNotFound
+	codeErrInvalidRecipient
+	codeErrInvalidAgeHeader
+	codeErrInvalidMessage
+	codeErrMessageTooLarge
+)
+
+// x/e2ee module sentinel errors
+var (
+	ErrInvalidNotAfter  = errors.Register(ModuleName, codeErrInvalidNotAfter, "invalid not_after height")
+	ErrKeyNotFound      = errors.Register(ModuleName, codeErrKeyNotFound, "encryption key not found")
+	ErrInvalidRecipient = errors.Register(ModuleName, codeErrInvalidRecipient, "invalid recipient key")
+	ErrInvalidAgeHeader = errors.Register(ModuleName, codeErrInvalidAgeHeader, "invalid age header")
+	ErrInvalidMessage   = errors.Register(ModuleName, codeErrInvalidMessage, "invalid encrypted message")
+	ErrMessageTooLarge  = errors.Register(ModuleName, codeErrMessageTooLarge, "encrypted message too large")
+)
@@ -1,17 +1,104 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxGenesisKeyEntries caps the encryption key entries in the genesis and in the exported key sets.
+const MaxGenesisKeyEntries = 100_000
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	for _, key := range gs.Keys {
-		if err := key.Validate(); err != nil {
-			return err
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateKeyEntries(gs.Keys, func(s string) ([]byte, error) {
+		return sdk.AccAddressFromBech32(s)
+	}); err != nil {
+		return err
+	}
+	var lastID uint64
+	for i, msg := range gs.Messages {
+		if i > 0 && msg.Id <= lastID {
+			return fmt.Errorf("encrypted messages are not in the order of the id: %d", msg.Id)
+		}
+		lastID = msg.Id
+		if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+			return fmt.Errorf("invalid sender of encrypted message %d: %w", msg.Id, err)
+		}
+		if msg.Height < 0 {
+			return fmt.Errorf("negative height of encrypted message %d", msg.Id)
+		}
+		for _, recipient := range msg.Recipients {
+			if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+				return fmt.Errorf("invalid recipient of encrypted message %d: %w", msg.Id, err)
+			}
+		}
+		if err := ValidateEncryptedMessage(msg.Recipients, msg.Payload); err != nil {
+			return fmt.Errorf("invalid encrypted message %d: %w", msg.Id, err)
 		}
 	}
+	if len(gs.Messages) > 0 && gs.NextMessageId <= lastID {
+		return fmt.Errorf("next message id %d is not after the last message id %d", gs.NextMessageId, lastID)
+	}
 	return nil
 }
+
+// ValidateKeyEntries checks the encryption key entries are within the cap, have parseable addresses and recipients,
+// and form a single key history of each owner, the owner registers once at a height, so the latest record is the
+// current key, and keeps at most MaxKeyHistoryRecords records like the keeper. decodeAddress is the address codec of
+// the chain.
+func ValidateKeyEntries(entries []EncryptionKeyEntry, decodeAddress func(string) ([]byte, error)) error {
+	if len(entries) > MaxGenesisKeyEntries {
+		return fmt.Errorf("too many encryption key entries: %d, max %d", len(entries), MaxGenesisKeyEntries)
+	}
+	// the heights of the records of each owner, the addresses are compared in bytes, the bech32 strings are case
+	// insensitive.
+	histories := make(map[string]map[int64]struct{})
+	for _, entry := range entries {
+		addr, err := decodeAddress(entry.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address of the encryption key entry %s: %w", entry.Address, err)
+		}
+		if err := entry.validateKey(); err != nil {
+			return fmt.Errorf("invalid key of the encryption key entry %s: %w", entry.Address, err)
+		}
+		if entry.RegisteredHeight < 0 || entry.NotAfter < 0 || entry.RevokedHeight < 0 {
+			return fmt.Errorf("negative height in the encryption key entry of %s", entry.Address)
+		}
+		history, ok := histories[string(addr)]
+		if !ok {
+			history = make(map[int64]struct{})
+			histories[string(addr)] = history
+		}
+		if _, ok := history[entry.RegisteredHeight]; ok {
+			return fmt.Errorf("duplicated encryption key entry of %s at height %d", entry.Address, entry.RegisteredHeight)
+		}
+		if len(history) == MaxKeyHistoryRecords {
+			return fmt.Errorf("too many encryption key entries of %s, max %d", entry.Address, MaxKeyHistoryRecords)
+		}
+		history[entry.RegisteredHeight] = struct{}{}
+	}
+	return nil
+}
+
+// Record returns the key record of the genesis entry.
+func (e EncryptionKeyEntry) Record() EncryptionKeyRecord {
+	return EncryptionKeyRecord{
+		Key:              e.Key,
+		RegisteredHeight: e.RegisteredHeight,
+		NotAfter:         e.NotAfter,
+		RevokedHeight:    e.RevokedHeight,
+		KeyType:          e.KeyType,
+	}
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,10 +25,60 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// KeyType is the age recipient type of an encryption key.
+type KeyType int32
+
+const (
+	// KEY_TYPE_X25519 is the native X25519 recipient "age1...".
+	KeyTypeX25519 KeyType = 0
+	// KEY_TYPE_HYBRID is the post-quantum hybrid ML-KEM-768 + X25519 recipient
+	// "age1pq1...", it can't be mixed with the other types in an encryption.
+	KeyTypeHybrid KeyType = 1
+	// KEY_TYPE_SSH is the ssh-ed25519 or ssh-rsa public key in the
+	// authorized_keys format.
+	KeyTypeSSH KeyType = 2
+	// KEY_TYPE_PLUGIN is the recipient "age1name1..." of the age plugin
+	// "age-plugin-name", e.g. for the hardware backed keys.
+	KeyTypePlugin KeyType = 3
+)
+
+var KeyType_name = map[int32]string{
+	0: "KEY_TYPE_X25519",
+	1: "KEY_TYPE_HYBRID",
+	2: "KEY_TYPE_SSH",
+	3: "KEY_TYPE_PLUGIN",
+}
+
+var KeyType_value = map[string]int32{
+	"KEY_TYPE_X25519": 0,
+	"KEY_TYPE_HYBRID": 1,
+	"KEY_TYPE_SSH":    2,
+	"KEY_TYPE_PLUGIN": 3,
+}
+
+func (x KeyType) String() string {
+	return proto.EnumName(KeyType_name, int32(x))
+}
+
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e81aee24edfec633, []int{0}
+}
+
 // EncryptionKeyEntry is a type that contains the owner and the public key.
 type EncryptionKeyEntry struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// registered_height is the block height the key is registered at, the
+	// previous key of the owner is superseded from this height.
+	RegisteredHeight int64 `protobuf:"varint,3,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+	// not_after is the last block height the key is valid at, zero means no
+	// expiry.
+	NotAfter int64 `protobuf:"varint,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// revoked_height is the block height the key is revoked at, zero means not
+	// revoked.
+	RevokedHeight int64 `protobuf:"varint,5,opt,name=revoked_height,json=revokedHeight,proto3" json:"revoked_height,omitempty"`
+	// key_type is the recipient type of the key.
+	KeyType KeyType `protobuf:"varint,6,opt,name=key_type,json=keyType,proto3,enum=e2ee.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *EncryptionKeyEntry) Reset()         { *m = EncryptionKeyEntry{} }
@@ -76,17 +128,281 @@ func (m *EncryptionKeyEntry) GetKey() string {
 	return ""
 }
 
+func (m *EncryptionKeyEntry) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+func (m *EncryptionKeyEntry) GetNotAfter() int64 {
+	if m != nil {
+		return m.NotAfter
+	}
+	return 0
+}
+
+func (m *EncryptionKeyEntry) GetRevokedHeight() int64 {
+	if m != nil {
+		return m.RevokedHeight
+	}
+	return 0
+}
+
+func (m *EncryptionKeyEntry) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyTypeX25519
+}
+
+// EncryptionKeyRecord is a registered encryption key in the key history of an
+// owner.
+type EncryptionKeyRecord struct {
+	Key              string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RegisteredHeight int64  `protobuf:"varint,2,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+	// not_after is the last block height the key is valid at, zero means no
+	// expiry.
+	NotAfter int64 `protobuf:"varint,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// revoked_height is the block height the key is revoked at, zero means not
+	// revoked.
+	RevokedHeight int64 `protobuf:"varint,4,opt,name=revoked_height,json=revokedHeight,proto3" json:"revoked_height,omitempty"`
+	// key_type is the recipient type of the key, derived from the key at the
+	// registration.
+	KeyType KeyType `protobuf:"varint,5,opt,name=key_type,json=keyType,proto3,enum=e2ee.KeyType" json:"key_type,omitempty"`
+}
+
+func (m *EncryptionKeyRecord) Reset()         { *m = EncryptionKeyRecord{} }
+func (m *EncryptionKeyRecord) String() string { return proto.CompactTextString(m) }
+func (*EncryptionKeyRecord) ProtoMessage()    {}
+func (*EncryptionKeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e81aee24edfec633, []int{1}
+}
+func (m *EncryptionKeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionKeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionKeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionKeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionKeyRecord.Merge(m, src)
+}
+func (m *EncryptionKeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionKeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionKeyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionKeyRecord proto.InternalMessageInfo
+
+func (m *EncryptionKeyRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *EncryptionKeyRecord) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+func (m *EncryptionKeyRecord) GetNotAfter() int64 {
+	if m != nil {
+		return m.NotAfter
+	}
+	return 0
+}
+
+func (m *EncryptionKeyRecord) GetRevokedHeight() int64 {
+	if m != nil {
+		return m.RevokedHeight
+	}
+	return 0
+}
+
+func (m *EncryptionKeyRecord) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyTypeX25519
+}
+
+// Params defines the parameters of the e2ee module.
+type Params struct {
+	// max_message_size is the max size of the encrypted payload of a message sent
+	// to the inbox.
+	MaxMessageSize uint64 `protobuf:"varint,1,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"`
+	// fee_per_byte is charged to the sender for each byte of the encrypted
+	// payload, on top of the tx fee.
+	FeePerByte github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_per_byte,json=feePerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_byte"`
+	// retention_blocks is the number of blocks the messages are kept in the
+	// inbox before pruned.
+	RetentionBlocks int64 `protobuf:"varint,3,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e81aee24edfec633, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxMessageSize() uint64 {
+	if m != nil {
+		return m.MaxMessageSize
+	}
+	return 0
+}
+
+func (m *Params) GetFeePerByte() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return nil
+}
+
+func (m *Params) GetRetentionBlocks() int64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+// EncryptedMessage is an age encrypted message in the inbox of the recipients.
+type EncryptedMessage struct {
+	Id         uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender     string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// payload is the age encrypted message, with a stanza for each recipient.
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// height is the block height the message is sent at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EncryptedMessage) Reset()         { *m = EncryptedMessage{} }
+func (m *EncryptedMessage) String() string { return proto.CompactTextString(m) }
+func (*EncryptedMessage) ProtoMessage()    {}
+func (*EncryptedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e81aee24edfec633, []int{3}
+}
+func (m *EncryptedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedMessage.Merge(m, src)
+}
+func (m *EncryptedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedMessage proto.InternalMessageInfo
+
+func (m *EncryptedMessage) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EncryptedMessage) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EncryptedMessage) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *EncryptedMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *EncryptedMessage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // GenesisState defines the e2ee module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Keys []EncryptionKeyEntry `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+	Keys   []EncryptionKeyEntry `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+	Params Params               `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// messages are the encrypted messages not pruned yet, in the order of the id.
+	Messages []EncryptedMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages"`
+	// next_message_id is the id of the next encrypted message, the ids are never
+	// reused after the messages are pruned.
+	NextMessageId uint64 `protobuf:"varint,4,opt,name=next_message_id,json=nextMessageId,proto3" json:"next_message_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e81aee24edfec633, []int{1}
+	return fileDescriptor_e81aee24edfec633, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,30 +438,86 @@ func (m *GenesisState) GetKeys() []EncryptionKeyEntry {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetMessages() []EncryptedMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMessageId() uint64 {
+	if m != nil {
+		return m.NextMessageId
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("e2ee.KeyType", KeyType_name, KeyType_value)
 	proto.RegisterType((*EncryptionKeyEntry)(nil), "e2ee.EncryptionKeyEntry")
+	proto.RegisterType((*EncryptionKeyRecord)(nil), "e2ee.EncryptionKeyRecord")
+	proto.RegisterType((*Params)(nil), "e2ee.Params")
+	proto.RegisterType((*EncryptedMessage)(nil), "e2ee.EncryptedMessage")
 	proto.RegisterType((*GenesisState)(nil), "e2ee.GenesisState")
 }
 
 func init() { proto.RegisterFile("e2ee/genesis.proto", fileDescriptor_e81aee24edfec633) }
 
 var fileDescriptor_e81aee24edfec633 = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0x35, 0x4a, 0x4d,
-	0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
-	0x01, 0x89, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x05, 0xf4, 0x41, 0x2c, 0x88, 0x9c, 0x92,
-	0x03, 0x97, 0x90, 0x6b, 0x5e, 0x72, 0x51, 0x65, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x77, 0x6a, 0xa5,
-	0x6b, 0x5e, 0x49, 0x51, 0xa5, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc0, 0xc5, 0x9c, 0x9d, 0x5a, 0x29,
-	0xc1, 0x04, 0x16, 0x05, 0x31, 0x95, 0x9c, 0xb8, 0x78, 0xdc, 0x21, 0xd6, 0x05, 0x97, 0x24, 0x96,
-	0xa4, 0x0a, 0x19, 0x71, 0xb1, 0x64, 0xa7, 0x56, 0x82, 0x34, 0x32, 0x6b, 0x70, 0x1b, 0x49, 0xe8,
-	0x81, 0x2c, 0xd7, 0xc3, 0xb4, 0xc3, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xb0, 0x5a, 0x27,
-	0xcf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
-	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x1b, 0x91, 0xaf, 0x9b, 0x5f, 0x94, 0xae, 0x9b,
-	0x9c, 0x91, 0x98, 0x99, 0xa7, 0x9f, 0x5c, 0x94, 0x9f, 0x97, 0x5f, 0xac, 0x5f, 0xa1, 0x0f, 0xf6,
-	0x74, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x5f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x5e, 0x21, 0x2a, 0x6b, 0x09, 0x01, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6b, 0xe3, 0x46,
+	0x14, 0xb6, 0x6c, 0xc5, 0x49, 0x26, 0xb6, 0xa3, 0x4c, 0x4b, 0x50, 0x5d, 0x50, 0x8c, 0xa1, 0x45,
+	0x4d, 0x89, 0xd4, 0xb8, 0x04, 0xda, 0x63, 0xdd, 0x9a, 0xd8, 0xa4, 0x2d, 0x46, 0x4e, 0xa1, 0xe9,
+	0x45, 0xc8, 0xd2, 0x8b, 0x3c, 0x38, 0xd6, 0x88, 0x99, 0x49, 0xb0, 0xf2, 0x0b, 0x4a, 0xe8, 0xa1,
+	0x7f, 0x20, 0xa7, 0x9e, 0xda, 0x9f, 0xd1, 0x53, 0x0e, 0x7b, 0xc8, 0x71, 0x61, 0x61, 0x77, 0x49,
+	0xfe, 0xc8, 0x32, 0xd2, 0xc4, 0x89, 0x59, 0xd8, 0xec, 0x49, 0xf3, 0xbe, 0xf9, 0xde, 0xd3, 0x7c,
+	0xef, 0x7d, 0x33, 0x08, 0x43, 0x07, 0xc0, 0x8d, 0x21, 0x01, 0x4e, 0xb8, 0x93, 0x32, 0x2a, 0x28,
+	0xd6, 0x25, 0xd6, 0xfc, 0x34, 0xa6, 0x31, 0xcd, 0x01, 0x57, 0xae, 0x8a, 0xbd, 0xa6, 0x15, 0x52,
+	0x3e, 0xa3, 0xdc, 0x1d, 0x07, 0x1c, 0xdc, 0x8b, 0xfd, 0x31, 0x88, 0x60, 0xdf, 0x0d, 0x29, 0x49,
+	0x8a, 0xfd, 0xf6, 0x2b, 0x0d, 0xe1, 0x5e, 0x12, 0xb2, 0x2c, 0x15, 0x84, 0x26, 0x47, 0x90, 0xf5,
+	0x12, 0xc1, 0x32, 0x6c, 0xa2, 0xd5, 0x20, 0x8a, 0x18, 0x70, 0x6e, 0x6a, 0x2d, 0xcd, 0x5e, 0xf7,
+	0x1e, 0x42, 0x6c, 0xa0, 0xca, 0x14, 0x32, 0xb3, 0x9c, 0xa3, 0x72, 0x89, 0xbf, 0x46, 0x5b, 0x0c,
+	0x62, 0xc2, 0x05, 0x30, 0x88, 0xfc, 0x09, 0x90, 0x78, 0x22, 0xcc, 0x4a, 0x4b, 0xb3, 0x2b, 0x9e,
+	0xf1, 0xb8, 0xd1, 0xcf, 0x71, 0xfc, 0x39, 0x5a, 0x4f, 0xa8, 0xf0, 0x83, 0x53, 0x01, 0xcc, 0xd4,
+	0x73, 0xd2, 0x5a, 0x42, 0xc5, 0x0f, 0x32, 0xc6, 0x5f, 0xa0, 0x06, 0x83, 0x0b, 0x3a, 0x7d, 0x2c,
+	0xb3, 0x92, 0x33, 0xea, 0x0a, 0x55, 0x35, 0x6c, 0xb4, 0x36, 0x85, 0xcc, 0x17, 0x59, 0x0a, 0x66,
+	0xb5, 0xa5, 0xd9, 0x8d, 0x4e, 0xdd, 0x91, 0x2d, 0x70, 0x8e, 0x20, 0x3b, 0xce, 0x52, 0xf0, 0x56,
+	0xa7, 0xc5, 0xa2, 0xfd, 0xbf, 0x86, 0x3e, 0x59, 0x52, 0xe7, 0x41, 0x48, 0x59, 0xf4, 0x20, 0x42,
+	0x7b, 0x46, 0x44, 0xf9, 0x63, 0x44, 0x54, 0x9e, 0x15, 0xa1, 0x3f, 0x27, 0x62, 0xe5, 0x83, 0x22,
+	0x5e, 0x68, 0xa8, 0x3a, 0x0c, 0x58, 0x30, 0xe3, 0xd8, 0x46, 0xc6, 0x2c, 0x98, 0xfb, 0x33, 0xe0,
+	0x3c, 0x88, 0xc1, 0xe7, 0xe4, 0x12, 0x72, 0x11, 0xba, 0xd7, 0x98, 0x05, 0xf3, 0x5f, 0x0a, 0x78,
+	0x44, 0x2e, 0x01, 0xcf, 0x50, 0xed, 0x14, 0xc0, 0x4f, 0x81, 0xf9, 0xe3, 0x4c, 0x80, 0x59, 0x6e,
+	0x55, 0xec, 0x8d, 0xce, 0x67, 0x4e, 0x61, 0x07, 0x47, 0xda, 0xc1, 0x51, 0x76, 0x70, 0x7e, 0xa4,
+	0x24, 0xe9, 0x7e, 0x73, 0xf3, 0x7a, 0xa7, 0xf4, 0xdf, 0x9b, 0x1d, 0x3b, 0x26, 0x62, 0x72, 0x3e,
+	0x76, 0x42, 0x3a, 0x73, 0x95, 0x77, 0x8a, 0xcf, 0x1e, 0x8f, 0xa6, 0xae, 0x3c, 0x2e, 0xcf, 0x13,
+	0xb8, 0x87, 0x4e, 0x01, 0x86, 0xc0, 0xba, 0x99, 0x00, 0xfc, 0x15, 0x32, 0x18, 0x08, 0x48, 0x64,
+	0x9b, 0xfd, 0xf1, 0x19, 0x0d, 0xa7, 0x5c, 0x35, 0x66, 0x73, 0x81, 0x77, 0x73, 0xb8, 0xfd, 0x97,
+	0x86, 0x0c, 0x35, 0x13, 0x88, 0xd4, 0x91, 0x71, 0x03, 0x95, 0x49, 0xa4, 0xa4, 0x94, 0x49, 0x84,
+	0xb7, 0x51, 0x95, 0x43, 0x12, 0x01, 0x53, 0x46, 0x53, 0x11, 0xb6, 0x10, 0x62, 0x10, 0x92, 0x94,
+	0x40, 0x22, 0xe4, 0x1f, 0x2a, 0xf6, 0xba, 0xf7, 0x04, 0x91, 0xbe, 0x4d, 0x83, 0xec, 0x8c, 0x06,
+	0x51, 0xde, 0xf5, 0x9a, 0xf7, 0x10, 0xca, 0x8a, 0x4b, 0x9e, 0x52, 0x51, 0xfb, 0x56, 0x43, 0xb5,
+	0xc3, 0xe2, 0x3a, 0x8d, 0x44, 0x20, 0x00, 0x77, 0x90, 0x3e, 0x85, 0x4c, 0xfa, 0x5e, 0x76, 0xcc,
+	0x2c, 0x86, 0xf2, 0xfe, 0x15, 0xe9, 0xea, 0xb2, 0x61, 0x5e, 0xce, 0xc5, 0xbb, 0xa8, 0x9a, 0xe6,
+	0x13, 0xca, 0x8f, 0xbb, 0xd1, 0xa9, 0x15, 0x59, 0xc5, 0xd4, 0x14, 0x53, 0x31, 0xf0, 0x77, 0x68,
+	0x4d, 0xcd, 0xaf, 0x10, 0xb0, 0xd1, 0xd9, 0x5e, 0xfa, 0xc7, 0xa2, 0x29, 0x2a, 0x6f, 0xc1, 0xc6,
+	0x5f, 0xa2, 0xcd, 0x04, 0xe6, 0x62, 0x31, 0x7e, 0x52, 0x88, 0xd4, 0xbd, 0xba, 0x84, 0x55, 0xd6,
+	0x20, 0xda, 0xfd, 0x57, 0x43, 0xab, 0xca, 0x45, 0x32, 0xe7, 0xa8, 0x77, 0xe2, 0x1f, 0x9f, 0x0c,
+	0x7b, 0xfe, 0xef, 0x9d, 0x83, 0x83, 0xfd, 0xef, 0x8d, 0x52, 0x73, 0xeb, 0xea, 0xba, 0x55, 0x57,
+	0x8c, 0x02, 0x5c, 0xe2, 0xf5, 0x4f, 0xba, 0xde, 0xe0, 0x27, 0x43, 0x5b, 0xe2, 0xf5, 0xb3, 0x31,
+	0x23, 0x11, 0x6e, 0xa1, 0xda, 0x82, 0x37, 0x1a, 0xf5, 0x8d, 0x72, 0xb3, 0x71, 0x75, 0xdd, 0x42,
+	0x8a, 0x34, 0x1a, 0xf5, 0x97, 0x2a, 0x0d, 0x7f, 0xfe, 0xed, 0x70, 0xf0, 0xab, 0x51, 0x59, 0xaa,
+	0x34, 0x3c, 0x3b, 0x8f, 0x49, 0xd2, 0xd4, 0xff, 0xfc, 0xc7, 0x2a, 0x75, 0x07, 0x37, 0x77, 0x96,
+	0x76, 0x7b, 0x67, 0x69, 0x6f, 0xef, 0x2c, 0xed, 0xef, 0x7b, 0xab, 0x74, 0x7b, 0x6f, 0x95, 0x5e,
+	0xde, 0x5b, 0xa5, 0x3f, 0xdc, 0xa7, 0x46, 0x94, 0x8d, 0xa1, 0x7b, 0x94, 0xc5, 0x7b, 0xe1, 0x24,
+	0x20, 0x89, 0x1b, 0x32, 0x9a, 0x50, 0xee, 0xce, 0xdd, 0xfc, 0x39, 0xcc, 0x5d, 0x39, 0xae, 0xe6,
+	0x2f, 0xda, 0xb7, 0xef, 0x06, 0x00, 0xa4, 0x41, 0xb1, 0x53, 0x23, 0x05, 0x00, 0x00,
 }
 
 func (m *EncryptionKeyEntry) Marshal() (dAtA []byte, err error) {
@@ -168,6 +540,26 @@ func (m *EncryptionKeyEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RevokedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RevokedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NotAfter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NotAfter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
@@ -185,7 +577,7 @@ func (m *EncryptionKeyEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *EncryptionKeyRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -195,20 +587,75 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *EncryptionKeyRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EncryptionKeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+	if m.KeyType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RevokedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RevokedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NotAfter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NotAfter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeePerByte) > 0 {
+		for iNdEx := len(m.FeePerByte) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FeePerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -216,41 +663,255 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if m.MaxMessageSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMessageSize))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EncryptedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EncryptionKeyEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EncryptedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recipients[iNdEx])
+			copy(dAtA[i:], m.Recipients[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipients[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextMessageId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EncryptionKeyEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.RegisteredHeight))
+	}
+	if m.NotAfter != 0 {
+		n += 1 + sovGenesis(uint64(m.NotAfter))
+	}
+	if m.RevokedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.RevokedHeight))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovGenesis(uint64(m.KeyType))
+	}
+	return n
+}
+
+func (m *EncryptionKeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.RegisteredHeight))
+	}
+	if m.NotAfter != 0 {
+		n += 1 + sovGenesis(uint64(m.NotAfter))
+	}
+	if m.RevokedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.RevokedHeight))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovGenesis(uint64(m.KeyType))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMessageSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMessageSize))
+	}
+	if len(m.FeePerByte) > 0 {
+		for _, e := range m.FeePerByte {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.RetentionBlocks))
+	}
+	return n
+}
+
+func (m *EncryptedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -262,6 +923,17 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextMessageId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextMessageId))
+	}
 	return n
 }
 
@@ -364,6 +1036,82 @@ func (m *EncryptionKeyEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			m.NotAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedHeight", wireType)
+			}
+			m.RevokedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -385,7 +1133,7 @@ func (m *EncryptionKeyEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EncryptionKeyRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -408,17 +1156,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionKeyRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionKeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -428,26 +1176,578 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, EncryptionKeyEntry{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			m.NotAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedHeight", wireType)
+			}
+			m.RevokedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageSize", wireType)
+			}
+			m.MaxMessageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessageSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePerByte = append(m.FeePerByte, types.Coin{})
+			if err := m.FeePerByte[len(m.FeePerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, EncryptionKeyEntry{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, EncryptedMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMessageId", wireType)
+			}
+			m.NextMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMessageId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
+	"path/filepath"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -17,14 +21,42 @@ const (
 
 const (
 	DefaultKeyringName = "e2ee-identity"
+
+	// NodeSocketName is the unix socket serving the node-local Node service in the data directory of the node.
+	NodeSocketName = "e2ee.sock"
 )
 
+// NodeSocketPath returns the path of the unix socket serving the Node service of the node at home.
+func NodeSocketPath(home string) string {
+	return filepath.Join(home, "data", NodeSocketName)
+}
+
+// MaxKeyHistoryRecords caps the key records kept for each owner, the oldest ones are pruned first.
+const MaxKeyHistoryRecords = 100
+
 const (
 	prefixEncryptionKey = iota + 1
+	prefixEncryptionKeyHistory
+	prefixParams
+	prefixMessage
+	prefixInbox
+	prefixNextMessageID
 )
 
-var KeyPrefixEncryptionKey = []byte{prefixEncryptionKey}
+var (
+	// KeyPrefixEncryptionKey is the single key per owner layout of the consensus version 1, replaced by the
+	// key history.
+	KeyPrefixEncryptionKey        = []byte{prefixEncryptionKey}
+	KeyPrefixEncryptionKeyHistory = []byte{prefixEncryptionKeyHistory}
+	ParamsKey                     = []byte{prefixParams}
+	// KeyPrefixMessage stores the encrypted messages by id, so they are pruned in the order they are sent.
+	KeyPrefixMessage = []byte{prefixMessage}
+	// KeyPrefixInbox indexes the message ids by recipient.
+	KeyPrefixInbox   = []byte{prefixInbox}
+	NextMessageIDKey = []byte{prefixNextMessageID}
+)
 
+// KeyPrefix returns the key of the owner in the consensus version 1 layout.
 func KeyPrefix(addr sdk.AccAddress) []byte {
 	key := make([]byte, 1+len(addr))
 	key[0] = prefixEncryptionKey
@@ -37,5 +69,58 @@ func (e EncryptionKeyEntry) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
 		return err
 	}
-	return ValidateRecipientKey(e.Key)
+	return e.validateKey()
+}
+
+// validateKey checks the key is a supported recipient of the recorded type.
+func (e EncryptionKeyEntry) validateKey() error {
+	keyType, err := RecipientKeyType(e.Key)
+	if err != nil {
+		return err
+	}
+	if keyType != e.KeyType {
+		return ErrInvalidRecipient.Wrapf("key type %s doesn't match the key of type %s", e.KeyType, keyType)
+	}
+	return nil
+}
+
+// KeyHistoryPrefix returns the prefix of the key history of the owner.
+func KeyHistoryPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixEncryptionKeyHistory, address.MustLengthPrefix(addr)...)
+}
+
+// KeyHistoryKey returns the key of the record registered by the owner at the height.
+func KeyHistoryKey(addr sdk.AccAddress, height int64) []byte {
+	return append(KeyHistoryPrefix(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SplitKeyHistoryKey splits the key history key without the prefix into the owner and the registered height.
+func SplitKeyHistoryKey(key []byte) (sdk.AccAddress, int64, error) {
+	if len(key) == 0 || len(key) != 1+int(key[0])+8 {
+		return nil, 0, fmt.Errorf("invalid key history key: %X", key)
+	}
+	addr := sdk.AccAddress(key[1 : 1+key[0]])
+	return addr, int64(sdk.BigEndianToUint64(key[1+key[0]:])), nil
+}
+
+// ValidAt reports whether the key is valid at the height, regardless of the superseding registrations.
+func (r EncryptionKeyRecord) ValidAt(height int64) bool {
+	return r.RegisteredHeight <= height &&
+		(r.NotAfter == 0 || height <= r.NotAfter) &&
+		(r.RevokedHeight == 0 || height < r.RevokedHeight)
+}
+
+// MessageKey returns the key of the encrypted message.
+func MessageKey(id uint64) []byte {
+	return append(KeyPrefixMessage, sdk.Uint64ToBigEndian(id)...)
+}
+
+// InboxPrefix returns the prefix of the inbox of the recipient.
+func InboxPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixInbox, address.MustLengthPrefix(addr)...)
+}
+
+// InboxKey returns the key of the message id in the inbox of the recipient.
+func InboxKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(InboxPrefix(addr), sdk.Uint64ToBigEndian(id)...)
 }
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// KeySet is the file format of the encryption keys exported from a genesis, for moving the key sets between the
+// networks, the checksum detects the truncated or edited files.
+type KeySet struct {
+	Keys     []EncryptionKeyEntry `json:"keys"`
+	Checksum string               `json:"checksum"`
+}
+
+// NewKeySet returns the key set of the entries with the checksum.
+func NewKeySet(keys []EncryptionKeyEntry) (KeySet, error) {
+	checksum, err := KeySetChecksum(keys)
+	if err != nil {
+		return KeySet{}, err
+	}
+	return KeySet{Keys: keys, Checksum: checksum}, nil
+}
+
+// KeySetChecksum returns the hex encoded sha256 of the length prefixed protobuf encoding of the entries in order.
+func KeySetChecksum(keys []EncryptionKeyEntry) (string, error) {
+	h := sha256.New()
+	var size [8]byte
+	for _, key := range keys {
+		bz, err := key.Marshal()
+		if err != nil {
+			return "", err
+		}
+		binary.BigEndian.PutUint64(size[:], uint64(len(bz)))
+		h.Write(size[:])
+		h.Write(bz)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Verify checks the checksum and the entries of the key set.
+func (s KeySet) Verify(decodeAddress func(string) ([]byte, error)) error {
+	checksum, err := KeySetChecksum(s.Keys)
+	if err != nil {
+		return err
+	}
+	if checksum != s.Checksum {
+		return fmt.Errorf("checksum mismatch, expected %s, got %s", s.Checksum, checksum)
+	}
+	return ValidateKeyEntries(s.Keys, decodeAddress)
+}
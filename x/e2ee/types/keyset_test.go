@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+)
+
+func TestValidateKeyEntries(t *testing.T) {
+	codec := addresscodec.NewBech32Codec("crc")
+	addr, err := codec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	entry := types.EncryptionKeyEntry{Address: addr, Key: identity.Recipient().String(), RegisteredHeight: 1}
+
+	rotated := entry
+	rotated.RegisteredHeight = 2
+	require.NoError(t, types.ValidateKeyEntries([]types.EncryptionKeyEntry{entry, rotated}, codec.StringToBytes))
+
+	// the bech32 address in upper case is the same owner
+	duplicated := entry
+	duplicated.Address = strings.ToUpper(addr)
+	for _, entries := range [][]types.EncryptionKeyEntry{
+		{entry, entry},
+		{entry, duplicated},
+		{{Address: "invalid", Key: entry.Key}},
+		{{Address: addr, Key: "invalid"}},
+		{{Address: addr, Key: entry.Key, KeyType: types.KeyTypeHybrid}},
+		{{Address: addr, Key: entry.Key, RevokedHeight: -1}},
+		make([]types.EncryptionKeyEntry, types.MaxGenesisKeyEntries+1),
+	} {
+		require.Error(t, types.ValidateKeyEntries(entries, codec.StringToBytes))
+	}
+
+	// the key history of an owner is capped like in the keeper
+	history := make([]types.EncryptionKeyEntry, types.MaxKeyHistoryRecords+1)
+	for i := range history {
+		history[i] = entry
+		history[i].RegisteredHeight = int64(i + 1)
+	}
+	require.NoError(t, types.ValidateKeyEntries(history[:types.MaxKeyHistoryRecords], codec.StringToBytes))
+	require.Error(t, types.ValidateKeyEntries(history, codec.StringToBytes))
+	// the cap is per owner
+	other, err := codec.BytesToString(append(make([]byte, 19), 1))
+	require.NoError(t, err)
+	history[types.MaxKeyHistoryRecords].Address = other
+	require.NoError(t, types.ValidateKeyEntries(history, codec.StringToBytes))
+}
+
+func TestKeySet(t *testing.T) {
+	codec := addresscodec.NewBech32Codec("crc")
+	addr, err := codec.BytesToString(make([]byte, 20))
+	require.NoError(t, err)
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	keySet, err := types.NewKeySet([]types.EncryptionKeyEntry{
+		{Address: addr, Key: identity.Recipient().String(), RegisteredHeight: 1},
+	})
+	require.NoError(t, err)
+	require.NoError(t, keySet.Verify(codec.StringToBytes))
+
+	keySet.Keys[0].RegisteredHeight = 2
+	require.Error(t, keySet.Verify(codec.StringToBytes))
+}
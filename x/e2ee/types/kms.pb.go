@@ -0,0 +1,1312 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: e2ee/kms.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecipientRequest is the request type for the KeyService/Recipient RPC method.
+type RecipientRequest struct {
+	// key_id is the name of the identity in the remote keyring.
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (m *RecipientRequest) Reset()         { *m = RecipientRequest{} }
+func (m *RecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*RecipientRequest) ProtoMessage()    {}
+func (*RecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22e631d9b267fb9, []int{0}
+}
+func (m *RecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientRequest.Merge(m, src)
+}
+func (m *RecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientRequest proto.InternalMessageInfo
+
+func (m *RecipientRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+// RecipientResponse is the response type for the KeyService/Recipient RPC
+// method.
+type RecipientResponse struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *RecipientResponse) Reset()         { *m = RecipientResponse{} }
+func (m *RecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*RecipientResponse) ProtoMessage()    {}
+func (*RecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22e631d9b267fb9, []int{1}
+}
+func (m *RecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientResponse.Merge(m, src)
+}
+func (m *RecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientResponse proto.InternalMessageInfo
+
+func (m *RecipientResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// Stanza is a recipient stanza of the age header.
+type Stanza struct {
+	Type string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Body []byte   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (m *Stanza) Reset()         { *m = Stanza{} }
+func (m *Stanza) String() string { return proto.CompactTextString(m) }
+func (*Stanza) ProtoMessage()    {}
+func (*Stanza) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22e631d9b267fb9, []int{2}
+}
+func (m *Stanza) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stanza) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stanza.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stanza) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stanza.Merge(m, src)
+}
+func (m *Stanza) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stanza) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stanza.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stanza proto.InternalMessageInfo
+
+func (m *Stanza) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Stanza) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *Stanza) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+// UnwrapRequest is the request type for the KeyService/Unwrap RPC method.
+type UnwrapRequest struct {
+	// key_id is the name of the identity in the remote keyring.
+	KeyId   string    `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Stanzas []*Stanza `protobuf:"bytes,2,rep,name=stanzas,proto3" json:"stanzas,omitempty"`
+}
+
+func (m *UnwrapRequest) Reset()         { *m = UnwrapRequest{} }
+func (m *UnwrapRequest) String() string { return proto.CompactTextString(m) }
+func (*UnwrapRequest) ProtoMessage()    {}
+func (*UnwrapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22e631d9b267fb9, []int{3}
+}
+func (m *UnwrapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwrapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnwrapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwrapRequest.Merge(m, src)
+}
+func (m *UnwrapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwrapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwrapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwrapRequest proto.InternalMessageInfo
+
+func (m *UnwrapRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *UnwrapRequest) GetStanzas() []*Stanza {
+	if m != nil {
+		return m.Stanzas
+	}
+	return nil
+}
+
+// UnwrapResponse is the response type for the KeyService/Unwrap RPC method.
+type UnwrapResponse struct {
+	// file_key is empty if none of the stanzas is for the identity.
+	FileKey []byte `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
+}
+
+func (m *UnwrapResponse) Reset()         { *m = UnwrapResponse{} }
+func (m *UnwrapResponse) String() string { return proto.CompactTextString(m) }
+func (*UnwrapResponse) ProtoMessage()    {}
+func (*UnwrapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d22e631d9b267fb9, []int{4}
+}
+func (m *UnwrapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwrapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnwrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwrapResponse.Merge(m, src)
+}
+func (m *UnwrapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwrapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwrapResponse proto.InternalMessageInfo
+
+func (m *UnwrapResponse) GetFileKey() []byte {
+	if m != nil {
+		return m.FileKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RecipientRequest)(nil), "e2ee.RecipientRequest")
+	proto.RegisterType((*RecipientResponse)(nil), "e2ee.RecipientResponse")
+	proto.RegisterType((*Stanza)(nil), "e2ee.Stanza")
+	proto.RegisterType((*UnwrapRequest)(nil), "e2ee.UnwrapRequest")
+	proto.RegisterType((*UnwrapResponse)(nil), "e2ee.UnwrapResponse")
+}
+
+func init() { proto.RegisterFile("e2ee/kms.proto", fileDescriptor_d22e631d9b267fb9) }
+
+var fileDescriptor_d22e631d9b267fb9 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0xe9, 0x07, 0x5f, 0x91, 0x23, 0x12, 0x1d, 0xff, 0x90, 0x98, 0x86, 0x74, 0x61, 0x30,
+	0x86, 0x36, 0xc2, 0xd6, 0x95, 0x71, 0x43, 0x48, 0x5c, 0x94, 0xb8, 0x71, 0x43, 0x4a, 0x39, 0xc2,
+	0xa4, 0xd2, 0xa9, 0x33, 0x83, 0x3a, 0x6e, 0xbc, 0x05, 0x2f, 0xcb, 0x25, 0x4b, 0x97, 0x06, 0x6e,
+	0xc4, 0x4c, 0x5b, 0x54, 0x34, 0x71, 0x77, 0xce, 0x33, 0xef, 0xbc, 0xe7, 0x0f, 0x2a, 0xd8, 0x42,
+	0x74, 0xc3, 0x89, 0x70, 0x62, 0xce, 0x24, 0x23, 0x05, 0x9d, 0xdb, 0xc7, 0xb0, 0xe9, 0x61, 0x40,
+	0x63, 0x8a, 0x91, 0xf4, 0xf0, 0x6e, 0x8a, 0x42, 0x92, 0x5d, 0x30, 0x43, 0x54, 0x7d, 0x3a, 0xac,
+	0x1a, 0x75, 0xa3, 0x51, 0xf2, 0xfe, 0x87, 0xa8, 0x3a, 0x43, 0xfb, 0x14, 0xb6, 0xbe, 0x49, 0x45,
+	0xcc, 0x22, 0x81, 0xe4, 0x10, 0x4a, 0x7c, 0x09, 0x33, 0xf9, 0x17, 0xb0, 0x2f, 0xc0, 0xec, 0x49,
+	0x3f, 0x7a, 0xf2, 0x09, 0x81, 0x82, 0x54, 0x31, 0x66, 0x92, 0x24, 0xd6, 0xcc, 0xe7, 0x23, 0x51,
+	0xfd, 0x57, 0xcf, 0x6b, 0xa6, 0x63, 0xcd, 0x06, 0x6c, 0xa8, 0xaa, 0xf9, 0xba, 0xd1, 0x28, 0x7b,
+	0x49, 0x6c, 0x5f, 0xc2, 0xc6, 0x55, 0xf4, 0xc0, 0xfd, 0xf8, 0xef, 0x06, 0xc9, 0x11, 0x14, 0x45,
+	0x52, 0x2d, 0xb5, 0x5c, 0x6f, 0x95, 0x1d, 0x3d, 0xa3, 0x93, 0xb6, 0xe0, 0x2d, 0x1f, 0xed, 0x13,
+	0xa8, 0x2c, 0xfd, 0xb2, 0x29, 0x0e, 0x60, 0xed, 0x86, 0xde, 0x62, 0x3f, 0x44, 0x95, 0x58, 0x96,
+	0xbd, 0xa2, 0xce, 0xbb, 0xa8, 0x5a, 0xcf, 0x00, 0x5d, 0x54, 0x3d, 0xe4, 0xf7, 0x34, 0x40, 0x72,
+	0x06, 0xa5, 0xcf, 0x1d, 0x90, 0xbd, 0xd4, 0xfe, 0xe7, 0xfe, 0x6a, 0xfb, 0xbf, 0x78, 0x56, 0xa6,
+	0x0d, 0x66, 0x5a, 0x98, 0x6c, 0xa7, 0x92, 0x95, 0xb1, 0x6a, 0x3b, 0xab, 0x30, 0xfd, 0x74, 0xde,
+	0x79, 0x9d, 0x5b, 0xc6, 0x6c, 0x6e, 0x19, 0xef, 0x73, 0xcb, 0x78, 0x59, 0x58, 0xb9, 0xd9, 0xc2,
+	0xca, 0xbd, 0x2d, 0xac, 0xdc, 0xb5, 0x3b, 0xa2, 0x72, 0x3c, 0x1d, 0x38, 0x01, 0x9b, 0xb8, 0x01,
+	0x57, 0xb1, 0x64, 0x4d, 0xc6, 0x47, 0xcd, 0x60, 0xec, 0xd3, 0xc8, 0x0d, 0x38, 0x8b, 0x98, 0x70,
+	0x1f, 0xdd, 0xe4, 0xec, 0x7a, 0xdf, 0x62, 0x60, 0x26, 0x97, 0x6f, 0x7f, 0x0c, 0x00, 0x98, 0x0f,
+	0x30, 0x9b, 0x0b, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// KeyServiceClient is the client API for KeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KeyServiceClient interface {
+	// Recipient returns the recipient of the identity to register on chain.
+	Recipient(ctx context.Context, in *RecipientRequest, opts ...grpc.CallOption) (*RecipientResponse, error)
+	// Unwrap unwraps the file key from the recipient stanzas of an age header.
+	Unwrap(ctx context.Context, in *UnwrapRequest, opts ...grpc.CallOption) (*UnwrapResponse, error)
+}
+
+type keyServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewKeyServiceClient(cc grpc1.ClientConn) KeyServiceClient {
+	return &keyServiceClient{cc}
+}
+
+func (c *keyServiceClient) Recipient(ctx context.Context, in *RecipientRequest, opts ...grpc.CallOption) (*RecipientResponse, error) {
+	out := new(RecipientResponse)
+	err := c.cc.Invoke(ctx, "/e2ee.KeyService/Recipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Unwrap(ctx context.Context, in *UnwrapRequest, opts ...grpc.CallOption) (*UnwrapResponse, error) {
+	out := new(UnwrapResponse)
+	err := c.cc.Invoke(ctx, "/e2ee.KeyService/Unwrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyServiceServer is the server API for KeyService service.
+type KeyServiceServer interface {
+	// Recipient returns the recipient of the identity to register on chain.
+	Recipient(context.Context, *RecipientRequest) (*RecipientResponse, error)
+	// Unwrap unwraps the file key from the recipient stanzas of an age header.
+	Unwrap(context.Context, *UnwrapRequest) (*UnwrapResponse, error)
+}
+
+// UnimplementedKeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedKeyServiceServer struct {
+}
+
+func (*UnimplementedKeyServiceServer) Recipient(ctx context.Context, req *RecipientRequest) (*RecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recipient not implemented")
+}
+func (*UnimplementedKeyServiceServer) Unwrap(ctx context.Context, req *UnwrapRequest) (*UnwrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwrap not implemented")
+}
+
+func RegisterKeyServiceServer(s grpc1.Server, srv KeyServiceServer) {
+	s.RegisterService(&_KeyService_serviceDesc, srv)
+}
+
+func _KeyService_Recipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Recipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2ee.KeyService/Recipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Recipient(ctx, req.(*RecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Unwrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Unwrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2ee.KeyService/Unwrap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Unwrap(ctx, req.(*UnwrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "e2ee.KeyService",
+	HandlerType: (*KeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Recipient",
+			Handler:    _KeyService_Recipient_Handler,
+		},
+		{
+			MethodName: "Unwrap",
+			Handler:    _KeyService_Unwrap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "e2ee/kms.proto",
+}
+
+func (m *RecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintKms(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintKms(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stanza) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stanza) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stanza) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintKms(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintKms(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintKms(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnwrapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwrapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnwrapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stanzas) > 0 {
+		for iNdEx := len(m.Stanzas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stanzas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKms(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintKms(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnwrapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwrapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnwrapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileKey) > 0 {
+		i -= len(m.FileKey)
+		copy(dAtA[i:], m.FileKey)
+		i = encodeVarintKms(dAtA, i, uint64(len(m.FileKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKms(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKms(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKms(uint64(l))
+	}
+	return n
+}
+
+func (m *RecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovKms(uint64(l))
+	}
+	return n
+}
+
+func (m *Stanza) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovKms(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovKms(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovKms(uint64(l))
+	}
+	return n
+}
+
+func (m *UnwrapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKms(uint64(l))
+	}
+	if len(m.Stanzas) > 0 {
+		for _, e := range m.Stanzas {
+			l = e.Size()
+			n += 1 + l + sovKms(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnwrapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileKey)
+	if l > 0 {
+		n += 1 + l + sovKms(uint64(l))
+	}
+	return n
+}
+
+func sovKms(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKms(x uint64) (n int) {
+	return sovKms(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecipientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stanza) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stanza: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stanza: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnwrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnwrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnwrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stanzas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stanzas = append(m.Stanzas, &Stanza{})
+			if err := m.Stanzas[len(m.Stanzas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnwrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnwrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnwrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKms
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileKey = append(m.FileKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FileKey == nil {
+				m.FileKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKms(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKms
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKms
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKms
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKms
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKms
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKms        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKms          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKms = fmt.Errorf("proto: unexpected end of group")
+)
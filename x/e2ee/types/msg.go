@@ -3,22 +3,95 @@ package types
 import (
 	fmt "fmt"
 
-	"filippo.io/age"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = (*MsgRegisterEncryptionKey)(nil)
+var (
+	_ sdk.Msg = (*MsgRegisterEncryptionKey)(nil)
+	_ sdk.Msg = (*MsgRevokeEncryptionKey)(nil)
+	_ sdk.Msg = (*MsgSendEncrypted)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+)
 
 func (m *MsgRegisterEncryptionKey) ValidateBasic() error {
 	// validate bech32 format of Address
 	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
 		return fmt.Errorf("invalid address: %w", err)
 	}
+	if m.NotAfter < 0 {
+		return ErrInvalidNotAfter.Wrapf("negative height: %d", m.NotAfter)
+	}
 	return ValidateRecipientKey(m.Key)
 }
 
+func (m *MsgRevokeEncryptionKey) ValidateBasic() error {
+	// validate bech32 format of Address
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if m.Key == "" {
+		return ErrKeyNotFound.Wrap("empty key")
+	}
+	return nil
+}
+
+func (m *MsgSendEncrypted) ValidateBasic() error {
+	// validate bech32 format of Sender
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	for _, recipient := range m.Recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return fmt.Errorf("invalid recipient address: %w", err)
+		}
+	}
+	return ValidateEncryptedMessage(m.Recipients, m.Payload)
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	return m.Params.Validate()
+}
+
+// MaxMessageRecipients bounds the recipients of an encrypted message, each one is indexed in the inbox.
+const MaxMessageRecipients = 100
+
+// ValidateEncryptedMessage checks the recipients are unique and within the limit, and the payload is an age
+// encrypted blob with enough stanzas for the recipients, the size is checked against the params by the keeper.
+func ValidateEncryptedMessage(recipients []string, payload []byte) error {
+	if len(recipients) == 0 {
+		return ErrInvalidMessage.Wrap("no recipients")
+	}
+	if len(recipients) > MaxMessageRecipients {
+		return ErrInvalidMessage.Wrapf("too many recipients: %d (max %d)", len(recipients), MaxMessageRecipients)
+	}
+	seen := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		if _, ok := seen[recipient]; ok {
+			return ErrInvalidMessage.Wrapf("duplicated recipient: %s", recipient)
+		}
+		seen[recipient] = struct{}{}
+	}
+	if len(payload) == 0 {
+		return ErrInvalidMessage.Wrap("empty payload")
+	}
+	stanzas, err := CountRecipientStanzas(payload)
+	if err != nil {
+		return err
+	}
+	if stanzas < len(recipients) {
+		return ErrInvalidMessage.Wrapf("%d stanzas for %d recipients", stanzas, len(recipients))
+	}
+	return nil
+}
+
+// MaxRecipientKeySize bounds the size of a registered encryption key.
+const MaxRecipientKeySize = 4096
+
+// ValidateRecipientKey checks the key is a supported age recipient within the size limit.
 func ValidateRecipientKey(key string) error {
-	_, err := age.ParseX25519Recipient(key)
+	_, err := RecipientKeyType(key)
 	return err
 }
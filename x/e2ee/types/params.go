@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxMessageSize fits a few KB of plaintext with the stanzas of a handful of recipients.
+	DefaultMaxMessageSize = uint64(16384)
+	// MaxMessageSizeLimit caps max_message_size, the messages are kept in the state until pruned.
+	MaxMessageSizeLimit = uint64(1 << 20)
+	// DefaultRetentionBlocks keeps the messages for about a week at 6s blocks.
+	DefaultRetentionBlocks = int64(100000)
+)
+
+// DefaultParams returns the default parameters of the e2ee module.
+func DefaultParams() Params {
+	return Params{
+		MaxMessageSize:  DefaultMaxMessageSize,
+		FeePerByte:      sdk.Coins{},
+		RetentionBlocks: DefaultRetentionBlocks,
+	}
+}
+
+// Validate validates the parameters.
+func (p Params) Validate() error {
+	if p.MaxMessageSize == 0 || p.MaxMessageSize > MaxMessageSizeLimit {
+		return fmt.Errorf("max message size must be in (0, %d]: %d", MaxMessageSizeLimit, p.MaxMessageSize)
+	}
+	if err := p.FeePerByte.Validate(); err != nil {
+		return fmt.Errorf("invalid fee per byte: %w", err)
+	}
+	if p.RetentionBlocks <= 0 {
+		return fmt.Errorf("retention blocks must be positive: %d", p.RetentionBlocks)
+	}
+	return nil
+}
+
+// MessageFee returns the fee of the encrypted payload of the size.
+func (p Params) MessageFee(size int) sdk.Coins {
+	fee := sdk.NewCoins()
+	for _, coin := range p.FeePerByte {
+		fee = fee.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(size))))
+	}
+	return fee
+}
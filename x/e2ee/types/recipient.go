@@ -0,0 +1,67 @@
+package types
+
+import (
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"filippo.io/age/plugin"
+)
+
+const (
+	// sshRecipientPrefix starts the ssh public keys in the authorized_keys format, e.g. "ssh-ed25519 AAAA...".
+	sshRecipientPrefix = "ssh-"
+	// hybridRecipientHRP is the bech32 prefix of the post-quantum hybrid recipients.
+	hybridRecipientHRP = "age1pq"
+	// pluginRecipientHRPPrefix starts the bech32 prefix "age1name" of the plugin recipients.
+	pluginRecipientHRPPrefix = "age1"
+)
+
+// recipientHRP returns the bech32 human readable part of the age recipient, the separator is the last "1" as the
+// bech32 data charset doesn't contain it.
+func recipientHRP(key string) string {
+	i := strings.LastIndexByte(key, '1')
+	if i < 0 {
+		return ""
+	}
+	return strings.ToLower(key[:i])
+}
+
+// ParseRecipient parses the registered key into the age recipient and its type. The plugin recipients run the
+// plugin binary only when wrapping the file key, interacting with the user through the ui, so it can be nil when
+// the key is only validated.
+func ParseRecipient(key string, ui *plugin.ClientUI) (age.Recipient, KeyType, error) {
+	if len(key) > MaxRecipientKeySize {
+		return nil, 0, ErrInvalidRecipient.Wrapf("key size %d exceeds the max %d", len(key), MaxRecipientKeySize)
+	}
+
+	var (
+		recipient age.Recipient
+		keyType   KeyType
+		err       error
+	)
+	switch hrp := recipientHRP(key); {
+	case strings.HasPrefix(key, sshRecipientPrefix):
+		keyType = KeyTypeSSH
+		recipient, err = agessh.ParseRecipient(key)
+	case hrp == hybridRecipientHRP:
+		keyType = KeyTypeHybrid
+		recipient, err = age.ParseHybridRecipient(key)
+	case strings.HasPrefix(hrp, pluginRecipientHRPPrefix):
+		keyType = KeyTypePlugin
+		recipient, err = plugin.NewRecipient(key, ui)
+	default:
+		keyType = KeyTypeX25519
+		recipient, err = age.ParseX25519Recipient(key)
+	}
+	if err != nil {
+		return nil, 0, ErrInvalidRecipient.Wrap(err.Error())
+	}
+	return recipient, keyType, nil
+}
+
+// RecipientKeyType checks the key is a supported age recipient within the size limit, and returns its type.
+func RecipientKeyType(key string) (KeyType, error) {
+	_, keyType, err := ParseRecipient(key, nil)
+	return keyType, err
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/plugin"
+	"github.com/crypto-org-chain/cronos/x/e2ee/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestRecipientKeyType(t *testing.T) {
+	x25519, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	hybrid, err := age.GenerateHybridIdentity()
+	require.NoError(t, err)
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+
+	for key, expected := range map[string]types.KeyType{
+		x25519.Recipient().String(): types.KeyTypeX25519,
+		hybrid.Recipient().String(): types.KeyTypeHybrid,
+		strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub))) + " user@host": types.KeyTypeSSH,
+		plugin.EncodeRecipient("yubikey", []byte("stub")):                          types.KeyTypePlugin,
+	} {
+		keyType, err := types.RecipientKeyType(key)
+		require.NoError(t, err, key)
+		require.Equal(t, expected, keyType, key)
+	}
+
+	for _, key := range []string{
+		"",
+		x25519.Recipient().String() + "malformed",
+		"ssh-ed25519 malformed",
+		strings.Repeat("a", types.MaxRecipientKeySize+1),
+	} {
+		_, err := types.RecipientKeyType(key)
+		require.ErrorIs(t, err, types.ErrInvalidRecipient, key)
+	}
+}
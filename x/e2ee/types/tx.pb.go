@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -32,6 +33,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgRegisterEncryptionKey struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// not_after is the last block height the key is valid at, zero means no
+	// expiry.
+	NotAfter int64 `protobuf:"varint,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
 }
 
 func (m *MsgRegisterEncryptionKey) Reset()         { *m = MsgRegisterEncryptionKey{} }
@@ -81,6 +85,13 @@ func (m *MsgRegisterEncryptionKey) GetKey() string {
 	return ""
 }
 
+func (m *MsgRegisterEncryptionKey) GetNotAfter() int64 {
+	if m != nil {
+		return m.NotAfter
+	}
+	return 0
+}
+
 // MsgRegisterEncryptionKeyResponse defines the Msg/RegisterEncryptionKey response type
 type MsgRegisterEncryptionKeyResponse struct {
 }
@@ -118,31 +129,345 @@ func (m *MsgRegisterEncryptionKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterEncryptionKeyResponse proto.InternalMessageInfo
 
+// MsgRevokeEncryptionKey defines the Msg/RevokeEncryptionKey request type
+type MsgRevokeEncryptionKey struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// key is the registered key to revoke, all the registrations of the key are
+	// revoked.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *MsgRevokeEncryptionKey) Reset()         { *m = MsgRevokeEncryptionKey{} }
+func (m *MsgRevokeEncryptionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeEncryptionKey) ProtoMessage()    {}
+func (*MsgRevokeEncryptionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85e46bdbb1c358a8, []int{2}
+}
+func (m *MsgRevokeEncryptionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeEncryptionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeEncryptionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeEncryptionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeEncryptionKey.Merge(m, src)
+}
+func (m *MsgRevokeEncryptionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeEncryptionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeEncryptionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeEncryptionKey proto.InternalMessageInfo
+
+func (m *MsgRevokeEncryptionKey) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRevokeEncryptionKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// MsgRevokeEncryptionKeyResponse defines the Msg/RevokeEncryptionKey response type
+type MsgRevokeEncryptionKeyResponse struct {
+}
+
+func (m *MsgRevokeEncryptionKeyResponse) Reset()         { *m = MsgRevokeEncryptionKeyResponse{} }
+func (m *MsgRevokeEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeEncryptionKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85e46bdbb1c358a8, []int{3}
+}
+func (m *MsgRevokeEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeEncryptionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeEncryptionKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeEncryptionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeEncryptionKeyResponse proto.InternalMessageInfo
+
+// MsgSendEncrypted defines the Msg/SendEncrypted request type
+type MsgSendEncrypted struct {
+	Sender     string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// payload is the age encrypted message, it must have a stanza for each
+	// recipient.
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *MsgSendEncrypted) Reset()         { *m = MsgSendEncrypted{} }
+func (m *MsgSendEncrypted) String() string { return proto.CompactTextString(m) }
+func (*MsgSendEncrypted) ProtoMessage()    {}
+func (*MsgSendEncrypted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85e46bdbb1c358a8, []int{4}
+}
+func (m *MsgSendEncrypted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendEncrypted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendEncrypted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendEncrypted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendEncrypted.Merge(m, src)
+}
+func (m *MsgSendEncrypted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendEncrypted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendEncrypted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendEncrypted proto.InternalMessageInfo
+
+func (m *MsgSendEncrypted) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSendEncrypted) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *MsgSendEncrypted) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// MsgSendEncryptedResponse defines the Msg/SendEncrypted response type
+type MsgSendEncryptedResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgSendEncryptedResponse) Reset()         { *m = MsgSendEncryptedResponse{} }
+func (m *MsgSendEncryptedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendEncryptedResponse) ProtoMessage()    {}
+func (*MsgSendEncryptedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85e46bdbb1c358a8, []int{5}
+}
+func (m *MsgSendEncryptedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendEncryptedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendEncryptedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendEncryptedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendEncryptedResponse.Merge(m, src)
+}
+func (m *MsgSendEncryptedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendEncryptedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendEncryptedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendEncryptedResponse proto.InternalMessageInfo
+
+func (m *MsgSendEncryptedResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgUpdateParams defines the request type for updating e2ee params.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85e46bdbb1c358a8, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85e46bdbb1c358a8, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterEncryptionKey)(nil), "e2ee.MsgRegisterEncryptionKey")
 	proto.RegisterType((*MsgRegisterEncryptionKeyResponse)(nil), "e2ee.MsgRegisterEncryptionKeyResponse")
+	proto.RegisterType((*MsgRevokeEncryptionKey)(nil), "e2ee.MsgRevokeEncryptionKey")
+	proto.RegisterType((*MsgRevokeEncryptionKeyResponse)(nil), "e2ee.MsgRevokeEncryptionKeyResponse")
+	proto.RegisterType((*MsgSendEncrypted)(nil), "e2ee.MsgSendEncrypted")
+	proto.RegisterType((*MsgSendEncryptedResponse)(nil), "e2ee.MsgSendEncryptedResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "e2ee.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "e2ee.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("e2ee/tx.proto", fileDescriptor_85e46bdbb1c358a8) }
 
 var fileDescriptor_85e46bdbb1c358a8 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x35, 0x4a, 0x4d,
-	0xd5, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01, 0x71, 0xa5, 0xc4, 0x93,
-	0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14, 0x44, 0x5a,
-	0x29, 0x84, 0x4b, 0xc2, 0xb7, 0x38, 0x3d, 0x28, 0x35, 0x3d, 0xb3, 0xb8, 0x24, 0xb5, 0xc8, 0x35,
-	0x2f, 0xb9, 0xa8, 0xb2, 0xa0, 0x24, 0x33, 0x3f, 0xcf, 0x3b, 0xb5, 0x52, 0x48, 0x82, 0x8b, 0x3d,
-	0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15,
-	0x12, 0xe0, 0x62, 0xce, 0x4e, 0xad, 0x94, 0x60, 0x02, 0x8b, 0x82, 0x98, 0x56, 0x3c, 0x4d, 0xcf,
-	0x37, 0x68, 0xc1, 0xe4, 0x95, 0x94, 0xb8, 0x14, 0x70, 0x99, 0x1a, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0x6a, 0x94, 0xcb, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xcf, 0x25, 0x8a, 0xdd, 0x76,
-	0x39, 0x3d, 0x90, 0xcb, 0xf5, 0x70, 0x99, 0x23, 0xa5, 0x86, 0x5f, 0x1e, 0x66, 0x8f, 0x14, 0x6b,
-	0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0xa5, 0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x36, 0x20,
-	0x5f, 0x37, 0xbf, 0x28, 0x5d, 0x37, 0x39, 0x23, 0x31, 0x33, 0x4f, 0x3f, 0xb9, 0x28, 0x3f, 0x2f,
-	0xbf, 0x58, 0xbf, 0x42, 0x1f, 0x12, 0xaa, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xa0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x9d, 0x96, 0x6f, 0x6a, 0x01, 0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x93, 0xfc, 0xf9, 0xc9, 0x34, 0x2d, 0xd5, 0x42, 0x5b, 0x63, 0x8a, 0x89, 0x2c, 0x84,
+	0xa2, 0x48, 0x8d, 0x45, 0xb8, 0xe5, 0x46, 0x05, 0x42, 0x08, 0x45, 0x42, 0x46, 0x1c, 0xe0, 0x52,
+	0xb9, 0xf6, 0xb0, 0x59, 0x85, 0xec, 0xba, 0xbb, 0xdb, 0xaa, 0xbe, 0x21, 0x9e, 0x80, 0x47, 0xe9,
+	0x63, 0xf4, 0xd8, 0x1b, 0x9c, 0x10, 0x4a, 0x0e, 0x7d, 0x0d, 0xe4, 0x8d, 0xed, 0xa6, 0x21, 0xed,
+	0x81, 0x53, 0x66, 0xbe, 0x99, 0xf9, 0xbe, 0x4f, 0x33, 0x1b, 0xc3, 0x3a, 0xf6, 0x11, 0x7d, 0x7d,
+	0xda, 0x4b, 0xa4, 0xd0, 0x82, 0xd4, 0xb3, 0xd4, 0xd9, 0x89, 0x84, 0x9a, 0x08, 0xe5, 0x4f, 0x14,
+	0xf5, 0x4f, 0x9e, 0x65, 0x3f, 0xf3, 0xb2, 0x73, 0x9f, 0x0a, 0x2a, 0x4c, 0xe8, 0x67, 0x51, 0x8e,
+	0x12, 0xc3, 0x41, 0x91, 0xa3, 0x62, 0x6a, 0x8e, 0x79, 0x47, 0x60, 0x0f, 0x15, 0x0d, 0x90, 0x32,
+	0xa5, 0x51, 0xbe, 0xe2, 0x91, 0x4c, 0x13, 0xcd, 0x04, 0x7f, 0x8b, 0x29, 0xb1, 0xe1, 0xff, 0x30,
+	0x8e, 0x25, 0x2a, 0x65, 0x5b, 0x6d, 0xab, 0xd3, 0x0c, 0x8a, 0x94, 0x6c, 0x42, 0x6d, 0x8c, 0xa9,
+	0x5d, 0x35, 0x68, 0x16, 0x92, 0x87, 0xd0, 0xe4, 0x42, 0x1f, 0x84, 0x9f, 0x35, 0x4a, 0xbb, 0xd6,
+	0xb6, 0x3a, 0xb5, 0xe0, 0x0e, 0x17, 0xfa, 0x45, 0x96, 0x0f, 0x5a, 0xdf, 0x2e, 0xcf, 0xba, 0xc5,
+	0xb0, 0xe7, 0x41, 0xfb, 0x26, 0xc9, 0x00, 0x55, 0x22, 0xb8, 0x42, 0x2f, 0x80, 0x6d, 0xd3, 0x73,
+	0x22, 0xc6, 0xf8, 0xcf, 0xa6, 0x96, 0x74, 0xdb, 0xe0, 0xae, 0xe6, 0x2c, 0x55, 0x8f, 0x60, 0x73,
+	0xa8, 0xe8, 0x7b, 0xe4, 0x71, 0x5e, 0xc7, 0x98, 0x6c, 0x43, 0x43, 0x21, 0x8f, 0x51, 0xe6, 0x72,
+	0x79, 0x46, 0x5c, 0x00, 0x89, 0x11, 0x4b, 0x18, 0x72, 0xad, 0xec, 0x6a, 0xbb, 0xd6, 0x69, 0x06,
+	0x0b, 0x48, 0xe6, 0x33, 0x09, 0xd3, 0x2f, 0x22, 0x8c, 0xcd, 0x3a, 0x5a, 0x41, 0x91, 0x0e, 0xd6,
+	0x32, 0x57, 0x39, 0x8d, 0xd7, 0x05, 0x7b, 0x59, 0xb2, 0xb0, 0x43, 0x36, 0xa0, 0xca, 0x62, 0x23,
+	0x5b, 0x0f, 0xaa, 0x2c, 0xf6, 0xc6, 0x70, 0x77, 0xa8, 0xe8, 0x87, 0x24, 0x0e, 0x35, 0xbe, 0x0b,
+	0x65, 0x38, 0x51, 0x64, 0x17, 0x9a, 0xe1, 0xb1, 0x1e, 0x09, 0xc9, 0x74, 0x9a, 0x1b, 0xbc, 0x02,
+	0x48, 0x17, 0x1a, 0x89, 0xe9, 0x33, 0x4b, 0x59, 0xeb, 0xb7, 0x7a, 0xd8, 0x47, 0xec, 0xcd, 0x67,
+	0xf7, 0xeb, 0xe7, 0xbf, 0x1e, 0x57, 0x82, 0xbc, 0x63, 0xb0, 0x91, 0xb9, 0xba, 0x9a, 0xf5, 0x1e,
+	0xc0, 0xce, 0x92, 0x58, 0xe1, 0xab, 0xff, 0xa3, 0x0a, 0xb5, 0xa1, 0xa2, 0xe4, 0x00, 0xb6, 0x56,
+	0x3f, 0x1c, 0x77, 0xae, 0x73, 0xd3, 0x95, 0x9d, 0xa7, 0xb7, 0xd7, 0xcb, 0x05, 0x7c, 0x84, 0x7b,
+	0xab, 0x9e, 0xc0, 0xee, 0xc2, 0xf8, 0x5f, 0x55, 0xe7, 0xc9, 0x6d, 0xd5, 0x92, 0xfa, 0x35, 0xac,
+	0x2f, 0xdd, 0xb9, 0x1c, 0xbb, 0x86, 0x3b, 0xee, 0x6a, 0xbc, 0x24, 0x7a, 0x09, 0xad, 0x6b, 0x17,
+	0xd9, 0x2a, 0xfb, 0x17, 0x61, 0xe7, 0xd1, 0x4a, 0xb8, 0x60, 0x71, 0xfe, 0xfb, 0x7a, 0x79, 0xd6,
+	0xb5, 0xf6, 0xdf, 0x9c, 0x4f, 0x5d, 0xeb, 0x62, 0xea, 0x5a, 0xbf, 0xa7, 0xae, 0xf5, 0x7d, 0xe6,
+	0x56, 0x2e, 0x66, 0x6e, 0xe5, 0xe7, 0xcc, 0xad, 0x7c, 0xf2, 0x29, 0xd3, 0xa3, 0xe3, 0xc3, 0x5e,
+	0x24, 0x26, 0xbe, 0xb1, 0x20, 0xf6, 0x84, 0xa4, 0x7b, 0xd1, 0x28, 0x64, 0xdc, 0x8f, 0xa4, 0xe0,
+	0x42, 0xf9, 0xa7, 0xfe, 0xfc, 0x23, 0x91, 0x26, 0xa8, 0x0e, 0x1b, 0xe6, 0xff, 0xfd, 0xfc, 0xcf,
+	0x00, 0xe8, 0x8f, 0x21, 0x22, 0x39, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -159,6 +484,14 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// RegisterEncryptionKey registers a new encryption key to a specific account
 	RegisterEncryptionKey(ctx context.Context, in *MsgRegisterEncryptionKey, opts ...grpc.CallOption) (*MsgRegisterEncryptionKeyResponse, error)
+	// RevokeEncryptionKey retires a registered encryption key of a specific
+	// account
+	RevokeEncryptionKey(ctx context.Context, in *MsgRevokeEncryptionKey, opts ...grpc.CallOption) (*MsgRevokeEncryptionKeyResponse, error)
+	// SendEncrypted stores an age encrypted message in the inbox of the
+	// recipients
+	SendEncrypted(ctx context.Context, in *MsgSendEncrypted, opts ...grpc.CallOption) (*MsgSendEncryptedResponse, error)
+	// UpdateParams defines a method to update e2ee module params
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -178,10 +511,45 @@ func (c *msgClient) RegisterEncryptionKey(ctx context.Context, in *MsgRegisterEn
 	return out, nil
 }
 
+func (c *msgClient) RevokeEncryptionKey(ctx context.Context, in *MsgRevokeEncryptionKey, opts ...grpc.CallOption) (*MsgRevokeEncryptionKeyResponse, error) {
+	out := new(MsgRevokeEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/e2ee.Msg/RevokeEncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendEncrypted(ctx context.Context, in *MsgSendEncrypted, opts ...grpc.CallOption) (*MsgSendEncryptedResponse, error) {
+	out := new(MsgSendEncryptedResponse)
+	err := c.cc.Invoke(ctx, "/e2ee.Msg/SendEncrypted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/e2ee.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterEncryptionKey registers a new encryption key to a specific account
 	RegisterEncryptionKey(context.Context, *MsgRegisterEncryptionKey) (*MsgRegisterEncryptionKeyResponse, error)
+	// RevokeEncryptionKey retires a registered encryption key of a specific
+	// account
+	RevokeEncryptionKey(context.Context, *MsgRevokeEncryptionKey) (*MsgRevokeEncryptionKeyResponse, error)
+	// SendEncrypted stores an age encrypted message in the inbox of the
+	// recipients
+	SendEncrypted(context.Context, *MsgSendEncrypted) (*MsgSendEncryptedResponse, error)
+	// UpdateParams defines a method to update e2ee module params
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -191,6 +559,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RegisterEncryptionKey(ctx context.Context, req *MsgRegisterEncryptionKey) (*MsgRegisterEncryptionKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterEncryptionKey not implemented")
 }
+func (*UnimplementedMsgServer) RevokeEncryptionKey(ctx context.Context, req *MsgRevokeEncryptionKey) (*MsgRevokeEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEncryptionKey not implemented")
+}
+func (*UnimplementedMsgServer) SendEncrypted(ctx context.Context, req *MsgSendEncrypted) (*MsgSendEncryptedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEncrypted not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -214,6 +591,60 @@ func _Msg_RegisterEncryptionKey_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeEncryptionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2ee.Msg/RevokeEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeEncryptionKey(ctx, req.(*MsgRevokeEncryptionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendEncrypted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendEncrypted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendEncrypted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2ee.Msg/SendEncrypted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendEncrypted(ctx, req.(*MsgSendEncrypted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/e2ee.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "e2ee.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -222,6 +653,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterEncryptionKey",
 			Handler:    _Msg_RegisterEncryptionKey_Handler,
 		},
+		{
+			MethodName: "RevokeEncryptionKey",
+			Handler:    _Msg_RevokeEncryptionKey_Handler,
+		},
+		{
+			MethodName: "SendEncrypted",
+			Handler:    _Msg_SendEncrypted_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "e2ee/tx.proto",
@@ -247,6 +690,11 @@ func (m *MsgRegisterEncryptionKey) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.NotAfter != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NotAfter))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
@@ -287,19 +735,216 @@ func (m *MsgRegisterEncryptionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRevokeEncryptionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterEncryptionKey) Size() (n int) {
-	if m == nil {
+
+func (m *MsgRevokeEncryptionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeEncryptionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeEncryptionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeEncryptionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeEncryptionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendEncrypted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendEncrypted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendEncrypted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recipients[iNdEx])
+			copy(dAtA[i:], m.Recipients[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Recipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendEncryptedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendEncryptedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendEncryptedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterEncryptionKey) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -312,6 +957,9 @@ func (m *MsgRegisterEncryptionKey) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.NotAfter != 0 {
+		n += 1 + sovTx(uint64(m.NotAfter))
+	}
 	return n
 }
 
@@ -324,6 +972,91 @@ func (m *MsgRegisterEncryptionKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeEncryptionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeEncryptionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendEncrypted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, s := range m.Recipients {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendEncryptedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -423,6 +1156,25 @@ func (m *MsgRegisterEncryptionKey) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			m.NotAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -494,6 +1246,552 @@ func (m *MsgRegisterEncryptionKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeEncryptionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeEncryptionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeEncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeEncryptionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeEncryptionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeEncryptionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendEncrypted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendEncrypted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendEncrypted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendEncryptedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendEncryptedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendEncryptedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0